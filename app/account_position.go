@@ -0,0 +1,69 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// AccountPosition returns the position of the account. Unknown accounts get
+// an empty position.
+func (app *EveApp) AccountPosition(ctx sdk.Context, addr sdk.AccAddress) (AccountPosition, error) {
+	position := AccountPosition{
+		Address:     addr.String(),
+		Balances:    app.BankKeeper.GetAllBalances(ctx, addr),
+		Delegations: []DelegationPosition{},
+		Unbondings:  []UnbondingPosition{},
+		Rewards:     sdk.DecCoins{},
+	}
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return AccountPosition{}, err
+	}
+
+	delegations, err := app.StakingKeeper.GetAllDelegatorDelegations(ctx, addr)
+	if err != nil {
+		return AccountPosition{}, err
+	}
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return AccountPosition{}, err
+		}
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return AccountPosition{}, err
+		}
+		position.Delegations = append(position.Delegations, DelegationPosition{
+			ValidatorAddress: delegation.ValidatorAddress,
+			Shares:           delegation.Shares,
+			Balance:          sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()),
+		})
+	}
+
+	unbondings, err := app.StakingKeeper.GetAllUnbondingDelegations(ctx, addr)
+	if err != nil {
+		return AccountPosition{}, err
+	}
+	for _, unbonding := range unbondings {
+		for _, entry := range unbonding.Entries {
+			position.Unbondings = append(position.Unbondings, UnbondingPosition{
+				ValidatorAddress: unbonding.ValidatorAddress,
+				Balance:          sdk.NewCoin(bondDenom, entry.Balance),
+				CompletionTime:   entry.CompletionTime,
+			})
+		}
+	}
+
+	if len(delegations) > 0 {
+		rewards, err := distrkeeper.NewQuerier(app.DistrKeeper).DelegationTotalRewards(ctx, &distrtypes.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: addr.String(),
+		})
+		if err != nil {
+			return AccountPosition{}, err
+		}
+		position.Rewards = rewards.Total
+	}
+	return position, nil
+}
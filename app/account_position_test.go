@@ -0,0 +1,89 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAccountPosition(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))[0]
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	require.NoError(t, err)
+	shares, err := app.StakingKeeper.Delegate(ctx, addr, sdkmath.NewInt(400_000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	unbondShares := shares.QuoInt64(4)
+	_, unbonded, err := app.StakingKeeper.Undelegate(ctx, addr, valAddr, unbondShares)
+	require.NoError(t, err)
+
+	// rewards accrue from the block following the delegation
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	validator, err = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, validator.Tokens.ToLegacyDec()))
+	require.NoError(t, app.DistrKeeper.AllocateTokensToValidator(ctx, validator, rewards))
+
+	position, err := app.AccountPosition(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, addr.String(), position.Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 600_000)), position.Balances)
+	require.Len(t, position.Delegations, 1)
+	require.Equal(t, validator.OperatorAddress, position.Delegations[0].ValidatorAddress)
+	require.Equal(t, shares.Sub(unbondShares), position.Delegations[0].Shares)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 300_000), position.Delegations[0].Balance)
+	require.Len(t, position.Unbondings, 1)
+	require.Equal(t, sdk.NewCoin(bondDenom, unbonded), position.Unbondings[0].Balance)
+	require.False(t, position.Rewards.IsZero())
+	require.Equal(t, bondDenom, position.Rewards[0].Denom)
+
+	_, _, unknown := testdata.KeyTestPubAddr()
+	empty, err := app.AccountPosition(ctx, unknown)
+	require.NoError(t, err)
+	require.Equal(t, AccountPosition{
+		Address:     unknown.String(),
+		Balances:    sdk.Coins{},
+		Delegations: []DelegationPosition{},
+		Unbondings:  []UnbondingPosition{},
+		Rewards:     sdk.DecCoins{},
+	}, empty)
+}
+
+func TestAccountPositionQuery(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))[0]
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdkmath.NewInt(400_000), stakingtypes.Unbonded, validators[0], true)
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	var res QueryAccountPositionResponse
+	queryApp(t, app, "AccountPosition", &QueryAccountPositionRequest{Address: addr.String()}, &res)
+	require.Equal(t, addr.String(), res.Position.Address)
+	require.Len(t, res.Position.Delegations, 1)
+	require.Equal(t, validators[0].OperatorAddress, res.Position.Delegations[0].ValidatorAddress)
+
+	_, err = queryServer{app: app}.AccountPosition(ctx, &QueryAccountPositionRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -3,15 +3,18 @@ package ante
 import (
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/hashicorp/go-metrics"
 	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
 	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
 	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 
 	corestoretypes "cosmossdk.io/core/store"
+	sdkmath "cosmossdk.io/math"
 	circuitante "cosmossdk.io/x/circuit/ante"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
@@ -24,15 +27,22 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper             *keeper.Keeper
-	WasmConfig            *wasmTypes.WasmConfig
-	WasmKeeper            *wasmkeeper.Keeper
-	TXCounterStoreService corestoretypes.KVStoreService
-	CircuitKeeper         *circuitkeeper.Keeper
-	FeeAbskeeper          feeabskeeper.Keeper
-	FeeMarketKeeper       feemarketante.FeeMarketKeeper
-	AccountKeeper         feemarketante.AccountKeeper
-	BankKeeper            feemarketante.BankKeeper
+	IBCKeeper                    *keeper.Keeper
+	WasmConfig                   *wasmTypes.WasmConfig
+	WasmKeeper                   *wasmkeeper.Keeper
+	TXCounterStoreService        corestoretypes.KVStoreService
+	CircuitKeeper                *circuitkeeper.Keeper
+	FeeAbskeeper                 feeabskeeper.Keeper
+	FeeMarketKeeper              feemarketante.FeeMarketKeeper
+	AccountKeeper                feemarketante.AccountKeeper
+	BankKeeper                   feemarketante.BankKeeper
+	MaxMsgsPerTx                 int
+	MaxGovMsgsPerTx              int
+	WasmAllowlistKeeper          WasmAllowlistKeeper
+	WasmMigrationAllowlistKeeper WasmMigrationAllowlistKeeper
+	MsgRouter                    MsgRouter
+	DenomResolver                NativeDenomResolver
+	FeeBypassKeeper              FeeBypassKeeper
 }
 
 // NewAnteHandler constructor
@@ -55,26 +65,50 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.CircuitKeeper == nil {
 		return nil, ErrMissingCircuitKeeper
 	}
+	if options.WasmAllowlistKeeper == nil {
+		return nil, ErrMissingWasmAllowlistKeeper
+	}
+	if options.WasmMigrationAllowlistKeeper == nil {
+		return nil, ErrMissingWasmMigrationAllowlistKeeper
+	}
+	if options.MsgRouter == nil {
+		return nil, ErrMissingMsgRouter
+	}
+	if options.DenomResolver == nil {
+		return nil, ErrMissingDenomResolver
+	}
+	if options.FeeBypassKeeper == nil {
+		return nil, ErrMissingFeeBypassKeeper
+	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewRegisteredMsgsDecorator(options.MsgRouter),
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
 		wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister()),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		feemarketante.NewFeeMarketCheckDecorator( // fee market check replaces fee deduct decorator
-			options.AccountKeeper,
-			options.BankKeeper,
-			options.FeegrantKeeper,
+		NewMaxMsgsDecorator(options.MaxMsgsPerTx, options.MaxGovMsgsPerTx),
+		NewWasmAllowlistDecorator(options.WasmAllowlistKeeper, options.WasmKeeper),
+		NewWasmMigrationAllowlistDecorator(options.WasmMigrationAllowlistKeeper),
+		NewFeeMarketFeeGuardDecorator(
 			options.FeeMarketKeeper,
-			ante.NewDeductFeeDecorator(
+			options.FeeBypassKeeper,
+			feemarketante.NewFeeMarketCheckDecorator( // fee market check replaces fee deduct decorator
 				options.AccountKeeper,
 				options.BankKeeper,
 				options.FeegrantKeeper,
-				options.TxFeeChecker,
+				options.FeeMarketKeeper,
+				ante.NewDeductFeeDecorator(
+					options.AccountKeeper,
+					options.BankKeeper,
+					options.FeegrantKeeper,
+					options.TxFeeChecker,
+				),
 			),
 		), // fees are deducted in the fee market deduct post handler
+		NewFeeDenomPriorityDecorator(options.DenomResolver),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
@@ -95,44 +129,86 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 type DenomResolverImpl struct {
 	FeeabsKeeper  feeabskeeper.Keeper
 	StakingKeeper feeabstypes.StakingKeeper
+	// FeeMarketKeeper holds the native fee denom of the chain, the fee denom of
+	// the fee market params. The bond denom is used when it is nil or the fee
+	// denom is empty.
+	FeeMarketKeeper feemarketante.FeeMarketKeeper
 }
 
 var _ feemarkettypes.DenomResolver = &DenomResolverImpl{}
 
 // ConvertToDenom converts any given coin to the native denom of the chain or the other way around.
 // Return error if neither of coin.Denom and denom is the native denom of the chain.
-// If the denom is the bond denom, convert `coin` to the native denom. return error if coin.Denom is not in the allowed list
-// If the denom is not the bond denom, convert the `coin` to the given denom. return error if denom is not in the allowed list
+// If the denom is the native denom, convert `coin` to the native denom. return error if coin.Denom is not in the allowed list
+// If the denom is not the native denom, convert the `coin` to the given denom. return error if denom is not in the allowed list
+// If coin.Denom already equals denom, the coin is returned unchanged.
+// Conversions and conversion failures are counted when telemetry is enabled.
 func (r *DenomResolverImpl) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
-	bondDenom, err := r.StakingKeeper.BondDenom(ctx)
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	converted, reason, err := r.convertToDenom(ctx, coin, denom)
 	if err != nil {
+		telemetry.IncrCounterWithLabels(
+			[]string{feeabstypes.ModuleName, "conversion_failure"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("reason", reason)},
+		)
 		return sdk.DecCoin{}, err
 	}
-	if denom != bondDenom && coin.Denom != bondDenom {
-		return sdk.DecCoin{}, ErrNeitherNativeDenom(coin.Denom, denom)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{feeabstypes.ModuleName, "conversion"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("from", coin.Denom), telemetry.NewLabel("to", denom)},
+	)
+	return converted, nil
+}
+
+// conversion failure reasons reported by ConvertToDenom
+const (
+	conversionFailureNativeDenom   = "native_denom"
+	conversionFailureNotNative     = "not_native"
+	conversionFailureNotRegistered = "not_registered"
+	conversionFailureTwapRate      = "twap_rate"
+	conversionFailureConversion    = "conversion"
+)
+
+// convertToDenom does the conversion of ConvertToDenom, returning the reason
+// of the failure along with the error.
+func (r *DenomResolverImpl) convertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, string, error) {
+	nativeDenom, err := r.NativeFeeDenom(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, conversionFailureNativeDenom, err
+	}
+	if denom != nativeDenom && coin.Denom != nativeDenom {
+		return sdk.DecCoin{}, conversionFailureNotNative, ErrNeitherNativeDenom(coin.Denom, denom)
 	}
-	var amount sdk.Coins
-	var hostZoneConfig feeabstypes.HostChainFeeAbsConfig
-	var found bool
 
-	if denom == bondDenom {
-		hostZoneConfig, found = r.FeeabsKeeper.GetHostZoneConfig(ctx, coin.Denom)
-		if !found {
-			return sdk.DecCoin{}, ErrDenomNotRegistered(coin.Denom)
-		}
-		amount, err = r.getIBCCoinFromNative(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt())), hostZoneConfig)
-	} else if coin.Denom == bondDenom {
-		hostZoneConfig, found := r.FeeabsKeeper.GetHostZoneConfig(ctx, denom)
-		if !found {
-			return sdk.DecCoin{}, ErrDenomNotRegistered(denom)
-		}
-		amount, err = r.FeeabsKeeper.CalculateNativeFromIBCCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, coin.Amount.TruncateInt())), hostZoneConfig)
+	ibcDenom := coin.Denom
+	if coin.Denom == nativeDenom {
+		ibcDenom = denom
+	}
+	hostZoneConfig, found := r.FeeabsKeeper.GetHostZoneConfig(ctx, ibcDenom)
+	if !found {
+		return sdk.DecCoin{}, conversionFailureNotRegistered, ErrDenomNotRegistered(ibcDenom)
+	}
+	twapRate, err := r.getTwapRate(ctx, hostZoneConfig)
+	if err != nil {
+		return sdk.DecCoin{}, conversionFailureTwapRate, err
 	}
 
+	var amount sdk.Coins
+	if denom == nativeDenom {
+		amount, err = r.getIBCCoinFromNative(ctx, sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt())), hostZoneConfig, twapRate)
+	} else {
+		amount, err = r.FeeabsKeeper.CalculateNativeFromIBCCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, coin.Amount.TruncateInt())), hostZoneConfig)
+	}
 	if err != nil {
-		return sdk.DecCoin{}, err
+		return sdk.DecCoin{}, conversionFailureConversion, err
 	}
-	return sdk.NewDecCoinFromDec(denom, amount[0].Amount.ToLegacyDec()), nil
+	return sdk.NewDecCoinFromDec(denom, amount[0].Amount.ToLegacyDec()), "", nil
 }
 
 // extra denoms should be all denoms that have been registered via governance(host zone)
@@ -152,18 +228,13 @@ func (r *DenomResolverImpl) ExtraDenoms(ctx sdk.Context) ([]string, error) {
 // Helper functions for DenomResolver //
 // //////////////////////////////////////
 
-func (r *DenomResolverImpl) getIBCCoinFromNative(ctx sdk.Context, nativeCoins sdk.Coins, chainConfig feeabstypes.HostChainFeeAbsConfig) (coins sdk.Coins, err error) {
+func (r *DenomResolverImpl) getIBCCoinFromNative(ctx sdk.Context, nativeCoins sdk.Coins, chainConfig feeabstypes.HostChainFeeAbsConfig, twapRate sdkmath.LegacyDec) (coins sdk.Coins, err error) {
 	if len(nativeCoins) != 1 {
 		return sdk.Coins{}, ErrExpectedOneCoin(len(nativeCoins))
 	}
 
 	nativeCoin := nativeCoins[0]
 
-	twapRate, err := r.FeeabsKeeper.GetTwapRate(ctx, chainConfig.IbcDenom)
-	if err != nil {
-		return sdk.Coins{}, err
-	}
-
 	// Divide native amount by twap rate to get IBC amount
 	ibcAmount := nativeCoin.Amount.ToLegacyDec().Quo(twapRate).RoundInt()
 	ibcCoin := sdk.NewCoin(chainConfig.IbcDenom, ibcAmount)
@@ -177,6 +248,39 @@ func (r *DenomResolverImpl) getIBCCoinFromNative(ctx sdk.Context, nativeCoins sd
 	return sdk.NewCoins(ibcCoin), nil
 }
 
+// NativeFeeDenom returns the fee denom of the fee market params, falling back
+// to the bond denom.
+func (r *DenomResolverImpl) NativeFeeDenom(ctx sdk.Context) (string, error) {
+	if r.FeeMarketKeeper != nil {
+		params, err := r.FeeMarketKeeper.GetParams(ctx)
+		if err != nil {
+			return "", err
+		}
+		if params.FeeDenom != "" {
+			return params.FeeDenom, nil
+		}
+	}
+	return r.StakingKeeper.BondDenom(ctx)
+}
+
+// getTwapRate returns the twap rate of the host zone, refusing rates that can't
+// be used to price fees: zero ones, which are never a valid price, and the ones
+// of frozen host zones, which feeabs stops refreshing.
+func (r *DenomResolverImpl) getTwapRate(ctx sdk.Context, chainConfig feeabstypes.HostChainFeeAbsConfig) (sdkmath.LegacyDec, error) {
+	if chainConfig.Status == feeabstypes.HostChainFeeAbsStatus_FROZEN {
+		return sdkmath.LegacyDec{}, ErrFrozenHostZone(chainConfig.IbcDenom)
+	}
+
+	twapRate, err := r.FeeabsKeeper.GetTwapRate(ctx, chainConfig.IbcDenom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !twapRate.IsPositive() {
+		return sdkmath.LegacyDec{}, ErrInvalidTwapRate(chainConfig.IbcDenom, twapRate)
+	}
+	return twapRate, nil
+}
+
 // return err if IBC token isn't in allowed_list
 func (r *DenomResolverImpl) verifyIBCCoins(ctx sdk.Context, ibcCoins sdk.Coins) error {
 	if ibcCoins.Len() != 1 {
@@ -1,8 +1,10 @@
 package ante
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/go-metrics"
 	"github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
 	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
@@ -13,6 +15,7 @@ import (
 	"cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -127,3 +130,151 @@ func TestMempoolDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertToDenomSameDenom(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	resolver := &DenomResolverImpl{
+		FeeabsKeeper:  suite.feeabsKeeper,
+		StakingKeeper: suite.stakingKeeper,
+	}
+
+	// no expectations are set on the staking keeper mock, so any keeper read fails the test
+	for _, denom := range []string{"ueve", "ibcfee"} {
+		coin := sdk.NewDecCoinFromDec(denom, math.LegacyNewDecWithPrec(15, 1))
+		converted, err := resolver.ConvertToDenom(suite.ctx, coin, denom)
+		require.NoError(t, err)
+		require.Equal(t, coin, converted)
+	}
+}
+
+func TestConvertToDenomUnusableTwapRate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   types.HostChainFeeAbsStatus
+		twapRate math.LegacyDec
+		expErr   error
+	}{
+		{"usable twap rate, should pass", types.HostChainFeeAbsStatus_UPDATED, math.LegacyNewDec(2), nil},
+		{"zero twap rate, should fail", types.HostChainFeeAbsStatus_UPDATED, math.LegacyZeroDec(), ErrInvalidTwapRate("ibcfee", math.LegacyZeroDec())},
+		{"frozen host zone, should fail", types.HostChainFeeAbsStatus_FROZEN, math.LegacyNewDec(2), ErrFrozenHostZone("ibcfee")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			require.NoError(t, suite.feeabsKeeper.SetHostZoneConfig(suite.ctx, types.HostChainFeeAbsConfig{
+				IbcDenom:                "ibcfee",
+				OsmosisPoolTokenDenomIn: "osmosis",
+				PoolId:                  1,
+				Status:                  tc.status,
+			}))
+			suite.feeabsKeeper.SetTwapRate(suite.ctx, "ibcfee", tc.twapRate)
+			suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+			resolver := &DenomResolverImpl{
+				FeeabsKeeper:  suite.feeabsKeeper,
+				StakingKeeper: suite.stakingKeeper,
+			}
+
+			// both conversion directions go through the twap rate
+			_, toNativeErr := resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ibcfee", math.NewInt(1000)), "ueve")
+			_, fromNativeErr := resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ueve", math.NewInt(1000)), "ibcfee")
+			if tc.expErr != nil {
+				require.EqualError(t, toNativeErr, tc.expErr.Error())
+				require.EqualError(t, fromNativeErr, tc.expErr.Error())
+				return
+			}
+			require.NoError(t, toNativeErr)
+			require.NoError(t, fromNativeErr)
+		})
+	}
+}
+
+func TestConvertToDenomTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "eve"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{})
+		require.NoError(t, err)
+	})
+
+	suite := SetupTestSuite(t, true)
+	require.NoError(t, suite.feeabsKeeper.SetHostZoneConfig(suite.ctx, types.HostChainFeeAbsConfig{
+		IbcDenom:                "ibcfee",
+		OsmosisPoolTokenDenomIn: "osmosis",
+		PoolId:                  1,
+		Status:                  types.HostChainFeeAbsStatus_UPDATED,
+	}))
+	suite.feeabsKeeper.SetTwapRate(suite.ctx, "ibcfee", math.LegacyNewDec(2))
+	suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+	resolver := &DenomResolverImpl{
+		FeeabsKeeper:  suite.feeabsKeeper,
+		StakingKeeper: suite.stakingKeeper,
+	}
+
+	_, err = resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ibcfee", math.NewInt(1000)), "ueve")
+	require.NoError(t, err)
+	_, err = resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("unknown", math.NewInt(1000)), "ueve")
+	require.Error(t, err)
+	_, err = resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("unknown", math.NewInt(1000)), "ibcfee")
+	require.Error(t, err)
+
+	gathered, err := m.Gather(telemetry.FormatDefault)
+	require.NoError(t, err)
+	var summary metrics.MetricsSummary
+	require.NoError(t, json.Unmarshal(gathered.Metrics, &summary))
+	counters := make(map[string]int)
+	for _, counter := range summary.Counters {
+		switch counter.Name {
+		case "eve.feeabs.conversion":
+			counters[counter.DisplayLabels["from"]+">"+counter.DisplayLabels["to"]] += counter.Count
+		case "eve.feeabs.conversion_failure":
+			counters[counter.DisplayLabels["reason"]] += counter.Count
+		}
+	}
+	require.Equal(t, map[string]int{
+		"ibcfee>ueve":    1,
+		"not_registered": 1,
+		"not_native":     1,
+	}, counters)
+}
+
+func TestConvertToDenomNativeDenom(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	require.NoError(t, suite.feeabsKeeper.SetHostZoneConfig(suite.ctx, types.HostChainFeeAbsConfig{
+		IbcDenom:                "ibcfee",
+		OsmosisPoolTokenDenomIn: "osmosis",
+		PoolId:                  1,
+		Status:                  types.HostChainFeeAbsStatus_UPDATED,
+	}))
+	suite.feeabsKeeper.SetTwapRate(suite.ctx, "ibcfee", math.LegacyNewDec(2))
+	suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+	params, err := suite.feemarketKeeper.GetParams(suite.ctx)
+	require.NoError(t, err)
+	params.FeeDenom = "ufee"
+	require.NoError(t, suite.feemarketKeeper.SetParams(suite.ctx, params))
+	resolver := &DenomResolverImpl{
+		FeeabsKeeper:    suite.feeabsKeeper,
+		StakingKeeper:   suite.stakingKeeper,
+		FeeMarketKeeper: suite.feemarketKeeper,
+	}
+
+	// the fee denom of the fee market params is converted to and from
+	toNative, err := resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ibcfee", math.NewInt(1000)), "ufee")
+	require.NoError(t, err)
+	require.Equal(t, "ufee", toNative.Denom)
+	fromNative, err := resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ufee", math.NewInt(1000)), "ibcfee")
+	require.NoError(t, err)
+	require.Equal(t, "ibcfee", fromNative.Denom)
+
+	// the bond denom no longer is
+	_, err = resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ibcfee", math.NewInt(1000)), "ueve")
+	require.EqualError(t, err, ErrNeitherNativeDenom("ibcfee", "ueve").Error())
+	_, err = resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ueve", math.NewInt(1000)), "ibcfee")
+	require.EqualError(t, err, ErrNeitherNativeDenom("ueve", "ibcfee").Error())
+
+	// without a fee market keeper, the bond denom is used
+	resolver.FeeMarketKeeper = nil
+	converted, err := resolver.ConvertToDenom(suite.ctx, sdk.NewDecCoin("ibcfee", math.NewInt(1000)), "ueve")
+	require.NoError(t, err)
+	require.Equal(t, toNative.Amount, converted.Amount)
+}
@@ -6,12 +6,17 @@ import (
 )
 
 var (
-	ErrMissingAccountKeeper    = errors.New("account keeper is required for ante builder")
-	ErrMissingBankKeeper       = errors.New("bank keeper is required for ante builder")
-	ErrMissingSignModeHandler  = errors.New("sign mode handler is required for ante builder")
-	ErrMissingWasmConfig       = errors.New("wasm config is required for ante builder")
-	ErrMissingWasmStoreService = errors.New("wasm store service is required for ante builder")
-	ErrMissingCircuitKeeper    = errors.New("circuit keeper is required for ante builder")
+	ErrMissingAccountKeeper                = errors.New("account keeper is required for ante builder")
+	ErrMissingBankKeeper                   = errors.New("bank keeper is required for ante builder")
+	ErrMissingSignModeHandler              = errors.New("sign mode handler is required for ante builder")
+	ErrMissingWasmConfig                   = errors.New("wasm config is required for ante builder")
+	ErrMissingWasmStoreService             = errors.New("wasm store service is required for ante builder")
+	ErrMissingCircuitKeeper                = errors.New("circuit keeper is required for ante builder")
+	ErrMissingWasmAllowlistKeeper          = errors.New("wasm allowlist keeper is required for ante builder")
+	ErrMissingWasmMigrationAllowlistKeeper = errors.New("wasm migration allowlist keeper is required for ante builder")
+	ErrMissingMsgRouter                    = errors.New("msg service router is required for ante builder")
+	ErrMissingDenomResolver                = errors.New("denom resolver is required for ante builder")
+	ErrMissingFeeBypassKeeper              = errors.New("fee bypass keeper is required for ante builder")
 )
 
 func ErrNeitherNativeDenom(coinDenom, denom string) error {
@@ -25,3 +30,11 @@ func ErrDenomNotRegistered(denom string) error {
 func ErrExpectedOneCoin(count int) error {
 	return fmt.Errorf("expected exactly one native coin, got %d", count)
 }
+
+func ErrInvalidTwapRate(denom string, rate fmt.Stringer) error {
+	return fmt.Errorf("twap rate %s of denom %s is not positive", rate, denom)
+}
+
+func ErrFrozenHostZone(denom string) error {
+	return fmt.Errorf("host zone of denom %s is frozen, its twap rate is no longer updated", denom)
+}
@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"math"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// NewFeeAbsTxFeeChecker returns a TxFeeChecker that behaves like the SDK default
+// checker, except that fees paid in a fee-abstraction denom are converted to the
+// native denom through the DenomResolver before being compared against the
+// validator's minimum gas prices. If the resolver is a GasPriceFloorResolver,
+// fees paid in a denom with a gas price floor must also cover that floor.
+func NewFeeAbsTxFeeChecker(resolver feemarkettypes.DenomResolver) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// The gas price floors are governance params rather than local
+		// validator settings, so they are enforced in every mode.
+		if floors, ok := resolver.(GasPriceFloorResolver); ok {
+			if err := checkGasPriceFloors(ctx, floors, feeCoins, gas); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		// Ensure that the provided fees meet a minimum threshold for the validator,
+		// if this is a CheckTx. This is only for local mempool purposes, and thus
+		// is only ran on check tx.
+		if ctx.IsCheckTx() {
+			minGasPrices := ctx.MinGasPrices()
+			if !minGasPrices.IsZero() {
+				requiredFees := make(sdk.Coins, len(minGasPrices))
+
+				// Determine the required fees by multiplying each required minimum gas
+				// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+				glDec := sdkmath.LegacyNewDec(int64(gas))
+				for i, gp := range minGasPrices {
+					fee := gp.Amount.Mul(glDec)
+					requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+				}
+
+				nativeFees, err := convertFeesToMinGasPriceDenoms(ctx, resolver, feeCoins, minGasPrices)
+				if err != nil {
+					return nil, 0, err
+				}
+
+				if !nativeFees.IsAnyGTE(requiredFees) {
+					return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+				}
+			}
+		}
+
+		priority := getTxPriority(feeCoins, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
+
+// GasPriceFloorResolver is a DenomResolver which also knows the gas price floor
+// of each fee denom, see MinGasPriceFloorResolver.
+type GasPriceFloorResolver interface {
+	feemarkettypes.DenomResolver
+	GasPriceFloor(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+}
+
+// checkGasPriceFloors checks that each fee coin paid in a denom with a gas price
+// floor covers ceil(floor * gasLimit) on its own. Fee coins in other denoms are
+// left to the minimum gas prices check.
+func checkGasPriceFloors(ctx sdk.Context, floors GasPriceFloorResolver, feeCoins sdk.Coins, gas uint64) error {
+	glDec := sdkmath.LegacyNewDec(int64(gas))
+	for _, fee := range feeCoins {
+		floor, err := floors.GasPriceFloor(ctx, fee.Denom)
+		if err != nil {
+			return err
+		}
+		if !floor.IsPositive() {
+			continue
+		}
+		required := sdk.NewCoin(fee.Denom, floor.Mul(glDec).Ceil().RoundInt())
+		if fee.IsLT(required) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required by the %s gas price floor: %s", fee, fee.Denom, required)
+		}
+	}
+	return nil
+}
+
+// convertFeesToMinGasPriceDenoms returns the fees expressed in the denoms of the
+// minimum gas prices. Fee coins already in one of those denoms are kept as is,
+// the others are converted with the DenomResolver.
+func convertFeesToMinGasPriceDenoms(ctx sdk.Context, resolver feemarkettypes.DenomResolver, feeCoins sdk.Coins, minGasPrices sdk.DecCoins) (sdk.Coins, error) {
+	converted := sdk.NewCoins()
+	for _, fee := range feeCoins {
+		if minGasPrices.AmountOf(fee.Denom).IsPositive() {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		var err error
+		for _, gp := range minGasPrices {
+			var coin sdk.DecCoin
+			coin, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoinFromCoin(fee), gp.Denom)
+			if err == nil {
+				converted = converted.Add(sdk.NewCoin(gp.Denom, coin.Amount.TruncateInt()))
+				break
+			}
+		}
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s cannot be converted: %s", fee.Denom, err)
+		}
+	}
+	return converted, nil
+}
+
+// getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
+// provided in a transaction.
+func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
@@ -0,0 +1,167 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/eve-network/eve/app/mingasprices"
+	"github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	math "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestFeeAbsTxFeeChecker(t *testing.T) {
+	gasLimit := uint64(200000)
+	minGasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ueve", math.LegacyNewDecWithPrec(5, 3)))
+	requiredFee := math.NewInt(1000) // 0.005 * 200000
+	mockHostZoneConfig := types.HostChainFeeAbsConfig{
+		IbcDenom:                "ibcfee",
+		OsmosisPoolTokenDenomIn: "osmosis",
+		PoolId:                  1,
+		Status:                  types.HostChainFeeAbsStatus_UPDATED,
+	}
+
+	testCases := []struct {
+		name      string
+		feeAmount sdk.Coins
+		expErr    error
+	}{
+		{
+			"native fee, should pass",
+			sdk.NewCoins(sdk.NewCoin("ueve", requiredFee)),
+			nil,
+		},
+		{
+			"insufficient native fee, should fail",
+			sdk.NewCoins(sdk.NewCoin("ueve", requiredFee.SubRaw(1))),
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"registered ibc fee, should pass",
+			sdk.NewCoins(sdk.NewCoin("ibcfee", requiredFee)),
+			nil,
+		},
+		{
+			"insufficient registered ibc fee, should fail",
+			sdk.NewCoins(sdk.NewCoin("ibcfee", requiredFee.SubRaw(1))),
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"unregistered ibc fee, should fail",
+			sdk.NewCoins(sdk.NewCoin("unsupported", requiredFee)),
+			sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			require.NoError(t, suite.feeabsKeeper.SetHostZoneConfig(suite.ctx, mockHostZoneConfig))
+			suite.feeabsKeeper.SetTwapRate(suite.ctx, "ibcfee", math.LegacyNewDec(1))
+			suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetFeeAmount(tc.feeAmount)
+			accs := suite.CreateTestAccounts(1)
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+
+			checker := NewFeeAbsTxFeeChecker(&DenomResolverImpl{
+				FeeabsKeeper:  suite.feeabsKeeper,
+				StakingKeeper: suite.stakingKeeper,
+			})
+			fee, _, err := checker(suite.ctx.WithMinGasPrices(minGasPrice), suite.txBuilder.GetTx())
+
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.feeAmount, fee)
+		})
+	}
+}
+
+func TestFeeAbsTxFeeCheckerGasPriceFloors(t *testing.T) {
+	gasLimit := uint64(200000)
+	minGasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ueve", math.LegacyNewDecWithPrec(5, 3)))
+
+	testCases := []struct {
+		name      string
+		feeAmount sdk.Coins
+		checkTx   bool
+		expErr    error
+	}{
+		{
+			"fee at the gas price floor, should pass",
+			sdk.NewCoins(sdk.NewCoin("ibcstable", math.NewInt(4000))),
+			true,
+			nil,
+		},
+		{
+			"fee below the gas price floor, should fail",
+			sdk.NewCoins(sdk.NewCoin("ibcstable", math.NewInt(3999))),
+			true,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"fee below the gas price floor in deliver tx, should fail",
+			sdk.NewCoins(sdk.NewCoin("ibcstable", math.NewInt(3999))),
+			false,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"unlisted denom converted to the min gas price, should pass",
+			sdk.NewCoins(sdk.NewCoin("ibcfee", math.NewInt(500))),
+			true,
+			nil,
+		},
+		{
+			"insufficient unlisted denom, should fail",
+			sdk.NewCoins(sdk.NewCoin("ibcfee", math.NewInt(499))),
+			true,
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"native fee ignores its floor, should pass",
+			sdk.NewCoins(sdk.NewCoin("ueve", math.NewInt(1000))),
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			key := storetypes.NewKVStoreKey(mingasprices.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			minGasPricesKeeper := mingasprices.NewKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(key), "")
+			require.NoError(t, minGasPricesKeeper.SetParams(ctx, mingasprices.Params{MinGasPrices: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("ibcstable", math.LegacyNewDecWithPrec(2, 2)),
+				sdk.NewDecCoin("ueve", math.NewInt(100)),
+			)}))
+
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetFeeAmount(tc.feeAmount)
+			accs := suite.CreateTestAccounts(1)
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+
+			checker := NewFeeAbsTxFeeChecker(NewMinGasPriceFloorResolver(halvingResolver{}, minGasPricesKeeper))
+			fee, _, err := checker(ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrice), suite.txBuilder.GetTx())
+
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.feeAmount, fee)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/eve-network/eve/app/feebypass"
+	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeBypassKeeper defines the keeper of the governance controlled fee bypass
+// parameters.
+type FeeBypassKeeper interface {
+	GetParams(ctx context.Context) (feebypass.Params, error)
+}
+
+// FeeMarketFeeGuardDecorator wraps the fee market check. While the fee market
+// is enabled, it lets the fee bypass transactions through without fees and
+// rejects the other ones whose fee doesn't cover the base gas price times the
+// gas limit, naming the shortfall. The wrapped decorator handles everything
+// else, including the fee escrow.
+type FeeMarketFeeGuardDecorator struct {
+	feemarketKeeper feemarketante.FeeMarketKeeper
+	feeBypassKeeper FeeBypassKeeper
+	feeDecorator    sdk.AnteDecorator
+}
+
+func NewFeeMarketFeeGuardDecorator(feemarketKeeper feemarketante.FeeMarketKeeper, feeBypassKeeper FeeBypassKeeper, feeDecorator sdk.AnteDecorator) FeeMarketFeeGuardDecorator {
+	return FeeMarketFeeGuardDecorator{
+		feemarketKeeper: feemarketKeeper,
+		feeBypassKeeper: feeBypassKeeper,
+		feeDecorator:    feeDecorator,
+	}
+}
+
+// IsFeeBypassTx returns true when tx is let through without fees by the fee
+// bypass parameters of feeBypassKeeper.
+func IsFeeBypassTx(ctx sdk.Context, feeBypassKeeper FeeBypassKeeper, tx sdk.Tx) (bool, error) {
+	params, err := feeBypassKeeper.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.IsBypassTx(tx), nil
+}
+
+func (d FeeMarketFeeGuardDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params, err := d.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	// gentxs consume no fee
+	if !params.Enabled || ctx.BlockHeight() == 0 {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	bypass, err := IsFeeBypassTx(ctx, d.feeBypassKeeper, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if bypass {
+		return next(ctx, tx, simulate)
+	}
+	if !simulate {
+		if err := d.checkBaseFee(ctx, tx, params.FeeDenom); err != nil {
+			return ctx, err
+		}
+	}
+	return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// checkBaseFee checks that the fee, paid in a single denom, covers the base gas
+// price times the gas limit.
+func (d FeeMarketFeeGuardDecorator) checkBaseFee(ctx sdk.Context, tx sdk.Tx, feeDenom string) error {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	fees := feeTx.GetFee()
+	if len(fees) > 1 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in a single denom, got %s", fees)
+	}
+	fee := sdk.NewCoin(feeDenom, sdkmath.ZeroInt())
+	if len(fees) == 1 {
+		fee = fees[0]
+	}
+
+	gasPrice, err := d.feemarketKeeper.GetMinGasPrice(ctx, fee.Denom)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "no base gas price for fee denom %s: %s", fee.Denom, err)
+	}
+	required := sdk.NewCoin(fee.Denom, gasPrice.Amount.MulInt64(int64(feeTx.GetGas())).Ceil().TruncateInt())
+	if fee.IsGTE(required) {
+		return nil
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s is short of %s: base gas price %s times gas limit %d requires %s",
+		fee, required.Sub(fee), gasPrice, feeTx.GetGas(), required)
+}
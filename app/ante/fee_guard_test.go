@@ -0,0 +1,91 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/eve-network/eve/app/feebypass"
+	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestFeeMarketFeeGuardDecorator(t *testing.T) {
+	const gasLimit = 200_000
+	requiredFee := feemarkettypes.DefaultMinBaseGasPrice.MulInt64(gasLimit).Ceil().TruncateInt64()
+
+	bypassParams := feeBypassParams(feebypass.DefaultParams())
+
+	testCases := []struct {
+		name      string
+		fee       sdk.Coins
+		gas       uint64
+		bypass    bool
+		extraMsg  bool
+		escrow    bool
+		expErr    error
+		expErrMsg string
+	}{
+		{"fee covering the base fee, should pass", sdk.NewCoins(sdk.NewInt64Coin("ueve", requiredFee)), gasLimit, false, false, true, nil, ""},
+		{"fee short of the base fee, should fail", sdk.NewCoins(sdk.NewInt64Coin("ueve", requiredFee-10)), gasLimit, false, false, false, sdkerrors.ErrInsufficientFee, "is short of 10ueve"},
+		{"zero fee, should fail", sdk.Coins{}, gasLimit, false, false, false, sdkerrors.ErrInsufficientFee, "is short of"},
+		{"bypass msg with zero fee, should pass", sdk.Coins{}, gasLimit, true, false, false, nil, ""},
+		{"bypass msg with zero fee over the gas cap, should fail", sdk.Coins{}, bypassParams.MaxGas + 1, true, false, false, sdkerrors.ErrInsufficientFee, "is short of"},
+		{"bypass msg with other msgs and zero fee, should fail", sdk.Coins{}, gasLimit, true, true, false, sdkerrors.ErrInsufficientFee, "is short of"},
+		{"fee in several denoms, should fail", sdk.NewCoins(sdk.NewInt64Coin("ueve", requiredFee), sdk.NewInt64Coin("uatom", requiredFee)), gasLimit, false, false, false, sdkerrors.ErrInvalidCoins, "single denom"},
+		{"fee in an unknown denom, should fail", sdk.NewCoins(sdk.NewInt64Coin("uatom", requiredFee)), gasLimit, false, false, false, sdkerrors.ErrInvalidCoins, "no base gas price"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+			accs := suite.CreateTestAccounts(1)
+			signer := accs[0].acc.GetAddress()
+			suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+			if tc.escrow {
+				suite.bankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, mock.Anything,
+					feemarkettypes.FeeCollectorName, mock.Anything).Return(nil).Once()
+			}
+
+			var msgs []sdk.Msg
+			if tc.bypass {
+				msgs = append(msgs, &channeltypes.MsgRecvPacket{Signer: signer.String()})
+			}
+			if !tc.bypass || tc.extraMsg {
+				msgs = append(msgs, testdata.NewTestMsg(signer))
+			}
+			require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetGasLimit(tc.gas)
+			suite.txBuilder.SetFeeAmount(tc.fee)
+
+			decorator := NewFeeMarketFeeGuardDecorator(suite.feemarketKeeper, bypassParams, feemarketante.NewFeeMarketCheckDecorator(
+				suite.accountKeeper,
+				suite.bankKeeper,
+				suite.feeGrantKeeper,
+				suite.feemarketKeeper,
+				nil,
+			))
+			_, err := sdk.ChainAnteDecorators(decorator)(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// feeBypassParams is a FeeBypassKeeper returning fixed parameters.
+type feeBypassParams feebypass.Params
+
+func (p feeBypassParams) GetParams(context.Context) (feebypass.Params, error) {
+	return feebypass.Params(p), nil
+}
@@ -0,0 +1,89 @@
+package ante
+
+import (
+	"math"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NativeFeePriorityBoost multiplies the priority of the transactions paying
+// their fees in the native denom only.
+const NativeFeePriorityBoost = 2
+
+// NativeDenomResolver is a DenomResolver knowing the native fee denom of the
+// chain.
+type NativeDenomResolver interface {
+	feemarkettypes.DenomResolver
+	NativeFeeDenom(ctx sdk.Context) (string, error)
+}
+
+// FeeDenomPriorityDecorator sets the CheckTx priority of a transaction from
+// its fee per gas expressed in the native denom. Fees paid in other denoms are
+// converted with the DenomResolver, the ones that can't be converted are not
+// counted. Transactions paying in the native denom only get their priority
+// multiplied by NativeFeePriorityBoost, to favor native fee payments over
+// equivalent IBC ones. It must run after the fee checks, whose priority it
+// replaces.
+type FeeDenomPriorityDecorator struct {
+	resolver NativeDenomResolver
+}
+
+func NewFeeDenomPriorityDecorator(resolver NativeDenomResolver) FeeDenomPriorityDecorator {
+	return FeeDenomPriorityDecorator{resolver: resolver}
+}
+
+func (d FeeDenomPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	nativeDenom, err := d.resolver.NativeFeeDenom(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	nativeFee := sdkmath.LegacyZeroDec()
+	nativeOnly := true
+	for _, fee := range feeTx.GetFee() {
+		if fee.Denom == nativeDenom {
+			nativeFee = nativeFee.Add(fee.Amount.ToLegacyDec())
+			continue
+		}
+
+		nativeOnly = false
+		converted, err := d.resolver.ConvertToDenom(ctx, sdk.NewDecCoinFromCoin(fee), nativeDenom)
+		if err != nil {
+			continue
+		}
+		nativeFee = nativeFee.Add(converted.Amount)
+	}
+
+	priority := nativeFee.QuoInt64(int64(gas))
+	if nativeOnly {
+		priority = priority.MulInt64(NativeFeePriorityBoost)
+	}
+	return next(ctx.WithPriority(toPriority(priority)), tx, simulate)
+}
+
+// toPriority truncates the priority, capping it to the maximum int64.
+func toPriority(priority sdkmath.LegacyDec) int64 {
+	truncated := priority.TruncateInt()
+	if !truncated.IsInt64() {
+		return math.MaxInt64
+	}
+	return truncated.Int64()
+}
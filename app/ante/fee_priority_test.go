@@ -0,0 +1,57 @@
+package ante
+
+import (
+	"math"
+	"testing"
+
+	"github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeDenomPriorityDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	require.NoError(t, suite.feeabsKeeper.SetHostZoneConfig(suite.ctx, types.HostChainFeeAbsConfig{
+		IbcDenom:                "ibcfee",
+		OsmosisPoolTokenDenomIn: "osmosis",
+		PoolId:                  1,
+		Status:                  types.HostChainFeeAbsStatus_UPDATED,
+	}))
+	suite.feeabsKeeper.SetTwapRate(suite.ctx, "ibcfee", sdkmath.LegacyNewDec(1))
+	suite.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("ueve", nil).AnyTimes()
+	decorator := NewFeeDenomPriorityDecorator(&DenomResolverImpl{
+		FeeabsKeeper:  suite.feeabsKeeper,
+		StakingKeeper: suite.stakingKeeper,
+	})
+
+	priority := func(ctx sdk.Context, simulate bool, fees sdk.Coins, gas uint64) int64 {
+		suite.txBuilder.SetFeeAmount(fees)
+		suite.txBuilder.SetGasLimit(gas)
+		var got int64
+		_, err := decorator.AnteHandle(ctx.WithPriority(-1), suite.txBuilder.GetTx(), simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			got = ctx.Priority()
+			return ctx, nil
+		})
+		require.NoError(t, err)
+		return got
+	}
+
+	native := priority(suite.ctx, false, sdk.NewCoins(sdk.NewInt64Coin("ueve", 200_000)), 100_000)
+	ibc := priority(suite.ctx, false, sdk.NewCoins(sdk.NewInt64Coin("ibcfee", 200_000)), 100_000)
+	require.Equal(t, int64(2*NativeFeePriorityBoost), native)
+	require.Equal(t, int64(2), ibc)
+	require.Greater(t, native, ibc)
+
+	// fees in denoms that can't be converted are not counted
+	require.Equal(t, int64(2), priority(suite.ctx, false, sdk.NewCoins(sdk.NewInt64Coin("ueve", 200_000), sdk.NewInt64Coin("unknown", 1_000_000)), 100_000))
+	require.Equal(t, int64(0), priority(suite.ctx, false, sdk.NewCoins(sdk.NewInt64Coin("unknown", 1_000_000)), 100_000))
+	require.Equal(t, int64(math.MaxInt64), priority(suite.ctx, false, sdk.NewCoins(sdk.NewCoin("ueve", sdkmath.NewIntFromUint64(math.MaxUint64))), 1))
+
+	// the priority is left untouched outside of CheckTx
+	require.Equal(t, int64(-1), priority(suite.ctx, true, sdk.NewCoins(sdk.NewInt64Coin("ueve", 200_000)), 100_000))
+	require.Equal(t, int64(-1), priority(suite.ctx.WithIsCheckTx(false), false, sdk.NewCoins(sdk.NewInt64Coin("ueve", 200_000)), 100_000))
+}
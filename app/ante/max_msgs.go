@@ -0,0 +1,93 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	// DefaultMaxMsgsPerTx is the maximum number of messages allowed in a transaction.
+	DefaultMaxMsgsPerTx = 50
+	// DefaultMaxGovMsgsPerTx is the maximum number of messages allowed in a
+	// transaction made only of governance messages, e.g. a batch of votes.
+	DefaultMaxGovMsgsPerTx = 200
+)
+
+// MaxMsgsDecorator rejects transactions carrying more than maxMsgs messages,
+// or more than maxGovMsgs if they are made only of governance messages.
+// Messages wrapped in an authz MsgExec are counted one by one, so batches
+// can't be smuggled through authz. A zero limit disables the corresponding
+// check.
+type MaxMsgsDecorator struct {
+	maxMsgs    int
+	maxGovMsgs int
+}
+
+func NewMaxMsgsDecorator(maxMsgs, maxGovMsgs int) MaxMsgsDecorator {
+	return MaxMsgsDecorator{maxMsgs: maxMsgs, maxGovMsgs: maxGovMsgs}
+}
+
+func (d MaxMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	maxMsgs := d.maxMsgs
+	if isGovTx(msgs) {
+		maxMsgs = d.maxGovMsgs
+	}
+	if maxMsgs <= 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	count, err := countMsgs(msgs)
+	if err != nil {
+		return ctx, err
+	}
+	if count > maxMsgs {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx contains %d messages, at most %d are allowed", count, maxMsgs)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// countMsgs returns the number of messages, counting the ones executed
+// through authz instead of the MsgExec wrapping them.
+func countMsgs(msgs []sdk.Msg) (int, error) {
+	count := 0
+	for _, msg := range msgs {
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			count++
+			continue
+		}
+
+		inner, err := exec.GetMessages()
+		if err != nil {
+			return 0, err
+		}
+		innerCount, err := countMsgs(inner)
+		if err != nil {
+			return 0, err
+		}
+		count += innerCount
+	}
+	return count, nil
+}
+
+func isGovTx(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *govv1.MsgSubmitProposal, *govv1.MsgVote, *govv1.MsgVoteWeighted, *govv1.MsgDeposit,
+			*govv1beta1.MsgSubmitProposal, *govv1beta1.MsgVote, *govv1beta1.MsgVoteWeighted, *govv1beta1.MsgDeposit:
+		default:
+			return false
+		}
+	}
+	return true
+}
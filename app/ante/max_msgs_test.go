@@ -0,0 +1,68 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMaxMsgsDecorator(t *testing.T) {
+	const (
+		maxMsgs    = 3
+		maxGovMsgs = 5
+	)
+
+	suite := SetupTestSuite(t, true)
+	addr := suite.CreateTestAccounts(1)[0].acc.GetAddress()
+	testMsgs := func(n int) []sdk.Msg {
+		msgs := make([]sdk.Msg, n)
+		for i := range msgs {
+			msgs[i] = testdata.NewTestMsg(addr)
+		}
+		return msgs
+	}
+	votes := func(n int) []sdk.Msg {
+		msgs := make([]sdk.Msg, n)
+		for i := range msgs {
+			msgs[i] = govv1.NewMsgVote(addr, uint64(i), govv1.OptionYes, "")
+		}
+		return msgs
+	}
+	exec := authz.NewMsgExec(addr, testMsgs(maxMsgs))
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{"at the limit, should pass", testMsgs(maxMsgs), nil},
+		{"over the limit, should fail", testMsgs(maxMsgs + 1), sdkerrors.ErrInvalidRequest},
+		{"gov tx over the limit, should pass", votes(maxMsgs + 1), nil},
+		{"gov tx at the gov limit, should pass", votes(maxGovMsgs), nil},
+		{"gov tx over the gov limit, should fail", votes(maxGovMsgs + 1), sdkerrors.ErrInvalidRequest},
+		{"gov and other msgs over the limit, should fail", append(votes(maxMsgs), testdata.NewTestMsg(addr)), sdkerrors.ErrInvalidRequest},
+		{"authz exec over the limit, should fail", []sdk.Msg{testdata.NewTestMsg(addr), &exec}, sdkerrors.ErrInvalidRequest},
+	}
+
+	decorator := NewMaxMsgsDecorator(maxMsgs, maxGovMsgs)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := decorator.AnteHandle(suite.ctx, suite.txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
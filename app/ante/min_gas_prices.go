@@ -0,0 +1,74 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/eve-network/eve/app/mingasprices"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinGasPricesKeeper defines the keeper of the governance controlled gas price
+// floors of the fee denoms.
+type MinGasPricesKeeper interface {
+	GetParams(ctx context.Context) (mingasprices.Params, error)
+}
+
+// MinGasPriceFloorResolver is a NativeDenomResolver raising the prices
+// converted from the native denom to the floor of the target denom. It is meant
+// for x/feemarket's keeper, whose conversions from the native denom are all gas
+// prices, and for the fee checker used while x/feemarket is disabled, which
+// reads the floors through GasPriceFloor. The native denom's floor is
+// x/feemarket's MinBaseGasPrice, so a floor set for it is ignored.
+type MinGasPriceFloorResolver struct {
+	NativeDenomResolver
+	minGasPricesKeeper MinGasPricesKeeper
+}
+
+func NewMinGasPriceFloorResolver(resolver NativeDenomResolver, minGasPricesKeeper MinGasPricesKeeper) *MinGasPriceFloorResolver {
+	return &MinGasPriceFloorResolver{NativeDenomResolver: resolver, minGasPricesKeeper: minGasPricesKeeper}
+}
+
+func (r *MinGasPriceFloorResolver) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	converted, err := r.NativeDenomResolver.ConvertToDenom(ctx, coin, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	nativeDenom, err := r.NativeFeeDenom(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	if coin.Denom != nativeDenom {
+		return converted, nil
+	}
+
+	floor, err := r.GasPriceFloor(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	if converted.Amount.LT(floor) {
+		return sdk.NewDecCoinFromDec(denom, floor), nil
+	}
+	return converted, nil
+}
+
+// GasPriceFloor returns the gas price floor of the fee denom, zero if it has
+// none or if it is the native denom.
+func (r *MinGasPriceFloorResolver) GasPriceFloor(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error) {
+	nativeDenom, err := r.NativeFeeDenom(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if denom == nativeDenom {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	params, err := r.minGasPricesKeeper.GetParams(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return params.GasPriceFloor(denom), nil
+}
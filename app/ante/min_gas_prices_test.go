@@ -0,0 +1,110 @@
+package ante
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/eve-network/eve/app/mingasprices"
+	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// halvingResolver converts the native ueve to the ibc denoms at a twap rate of 2.
+type halvingResolver struct{}
+
+func (halvingResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	switch {
+	case coin.Denom == denom:
+		return coin, nil
+	case coin.Denom == "ueve":
+		return sdk.NewDecCoinFromDec(denom, coin.Amount.QuoInt64(2)), nil
+	case denom == "ueve":
+		return sdk.NewDecCoinFromDec(denom, coin.Amount.MulInt64(2)), nil
+	}
+	return sdk.DecCoin{}, fmt.Errorf("cannot convert %s to %s", coin.Denom, denom)
+}
+
+func (halvingResolver) ExtraDenoms(sdk.Context) ([]string, error) {
+	return []string{"ibcfee", "ibcstable"}, nil
+}
+
+func (halvingResolver) NativeFeeDenom(sdk.Context) (string, error) {
+	return "ueve", nil
+}
+
+func TestMinGasPriceFloorResolver(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	keys := storetypes.NewKVStoreKeys(mingasprices.StoreKey, feemarkettypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+	minGasPricesKeeper := mingasprices.NewKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(keys[mingasprices.StoreKey]), "")
+	resolver := NewMinGasPriceFloorResolver(halvingResolver{}, minGasPricesKeeper)
+
+	feemarketKeeper := feemarketkeeper.NewKeeper(suite.encCfg.Codec, keys[feemarkettypes.StoreKey], suite.accountKeeper, resolver, authtypes.NewModuleAddress("gov").String())
+	params := feemarkettypes.DefaultParams()
+	params.FeeDenom = "ueve"
+	require.NoError(t, feemarketKeeper.SetParams(ctx, params))
+	state := feemarkettypes.DefaultState()
+	state.BaseGasPrice = sdkmath.LegacyNewDec(10)
+	require.NoError(t, feemarketKeeper.SetState(ctx, state))
+
+	minGasPrice := func(denom string) sdk.DecCoin {
+		price, err := feemarketKeeper.GetMinGasPrice(ctx, denom)
+		require.NoError(t, err)
+		return price
+	}
+
+	// without floors, the base gas price is converted
+	require.Equal(t, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(5)), minGasPrice("ibcfee"))
+	require.Equal(t, sdk.NewDecCoin("ibcstable", sdkmath.NewInt(5)), minGasPrice("ibcstable"))
+
+	require.NoError(t, minGasPricesKeeper.SetParams(ctx, mingasprices.Params{MinGasPrices: sdk.NewDecCoins(
+		sdk.NewDecCoin("ibcstable", sdkmath.NewInt(8)),
+		sdk.NewDecCoin("ueve", sdkmath.NewInt(100)),
+	)}))
+
+	// the floor applies to its denom only, the native denom keeps its base gas price
+	require.Equal(t, sdk.NewDecCoin("ibcstable", sdkmath.NewInt(8)), minGasPrice("ibcstable"))
+	require.Equal(t, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(5)), minGasPrice("ibcfee"))
+	require.Equal(t, sdk.NewDecCoin("ueve", sdkmath.NewInt(10)), minGasPrice("ueve"))
+	prices, err := feemarketKeeper.GetMinGasPrices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(8), prices.AmountOf("ibcstable"))
+
+	// a converted price above the floor is kept
+	state.BaseGasPrice = sdkmath.LegacyNewDec(20)
+	require.NoError(t, feemarketKeeper.SetState(ctx, state))
+	require.Equal(t, sdk.NewDecCoin("ibcstable", sdkmath.NewInt(10)), minGasPrice("ibcstable"))
+
+	// fees are still converted to the native denom as is
+	converted, err := feemarketKeeper.ResolveToDenom(ctx, sdk.NewDecCoin("ibcstable", sdkmath.NewInt(3)), "ueve")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("ueve", sdkmath.NewInt(6)), converted)
+
+	// the fee market fee guard enforces the floor
+	state.BaseGasPrice = sdkmath.LegacyNewDec(10)
+	require.NoError(t, feemarketKeeper.SetState(ctx, state))
+	suite.txBuilder.SetGasLimit(100)
+	guard := NewFeeMarketFeeGuardDecorator(feemarketKeeper, nil, nil)
+	for _, tc := range []struct {
+		fee    sdk.Coin
+		expErr bool
+	}{
+		{sdk.NewInt64Coin("ibcstable", 799), true},
+		{sdk.NewInt64Coin("ibcstable", 800), false},
+		{sdk.NewInt64Coin("ibcfee", 500), false},
+		{sdk.NewInt64Coin("ibcfee", 499), true},
+	} {
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(tc.fee))
+		err := guard.checkBaseFee(ctx, suite.txBuilder.GetTx(), params.FeeDenom)
+		require.Equal(t, tc.expErr, err != nil, tc.fee)
+	}
+}
@@ -0,0 +1,58 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MsgRouter defines the msg service router method used to find out whether a
+// message can be handled.
+type MsgRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
+
+// RegisteredMsgsDecorator rejects transactions carrying a message without a
+// msg service handler, including when wrapped in an authz MsgExec. It runs
+// before any gas is charged for the tx size or the signatures, so such
+// transactions are dropped at almost no cost.
+type RegisteredMsgsDecorator struct {
+	router MsgRouter
+}
+
+func NewRegisteredMsgsDecorator(router MsgRouter) RegisteredMsgsDecorator {
+	return RegisteredMsgsDecorator{router: router}
+}
+
+func (d RegisteredMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d RegisteredMsgsDecorator) checkMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if d.router.HandlerByTypeURL(typeURL) == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unregistered message type %s", typeURL)
+		}
+
+		exec, ok := msg.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		inner, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		if err := d.checkMsgs(inner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
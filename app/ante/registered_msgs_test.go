@@ -0,0 +1,72 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockMsgRouter map[string]bool
+
+func (m mockMsgRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if !m[typeURL] {
+		return nil
+	}
+	return func(sdk.Context, sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }
+}
+
+func TestRegisteredMsgsDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	_, _, addr := testdata.KeyTestPubAddr()
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ueve", 1)))
+	// TestMsg is known to the interface registry but has no msg service handler
+	unknown := testdata.NewTestMsg(addr)
+	execSend := authz.NewMsgExec(addr, []sdk.Msg{send})
+	execUnknown := authz.NewMsgExec(addr, []sdk.Msg{unknown})
+
+	router := mockMsgRouter{
+		sdk.MsgTypeURL(send):      true,
+		sdk.MsgTypeURL(&execSend): true,
+	}
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{"registered msg, should pass", []sdk.Msg{send}, nil},
+		{"unregistered msg, should fail", []sdk.Msg{send, unknown}, sdkerrors.ErrUnknownRequest},
+		{"registered msg through authz, should pass", []sdk.Msg{&execSend}, nil},
+		{"unregistered msg through authz, should fail", []sdk.Msg{&execUnknown}, sdkerrors.ErrUnknownRequest},
+	}
+
+	decorator := NewRegisteredMsgsDecorator(router)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msgs...))
+			ctx := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			called := false
+			_, err := decorator.AnteHandle(ctx, suite.txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.False(t, called)
+				require.Zero(t, ctx.GasMeter().GasConsumed())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/eve-network/eve/app/wasmallowlist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// WasmAllowlistKeeper defines the keeper of the governance controlled wasm
+// allowlist.
+type WasmAllowlistKeeper interface {
+	GetAllowlist(ctx context.Context) (wasmallowlist.Allowlist, error)
+}
+
+// WasmAllowlistDecorator rejects the instantiation of code IDs and the
+// execution of contracts that are not on the wasm allowlist, including when
+// wrapped in an authz MsgExec, before the tx gets into the mempool. The app
+// checks the allowlist again where the wasm messages are handled, which also
+// covers the ones sent by contracts and interchain accounts.
+type WasmAllowlistDecorator struct {
+	allowlistKeeper WasmAllowlistKeeper
+	wasmKeeper      wasmallowlist.ContractInfoKeeper
+}
+
+func NewWasmAllowlistDecorator(allowlistKeeper WasmAllowlistKeeper, wasmKeeper wasmallowlist.ContractInfoKeeper) WasmAllowlistDecorator {
+	return WasmAllowlistDecorator{allowlistKeeper: allowlistKeeper, wasmKeeper: wasmKeeper}
+}
+
+func (d WasmAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	allowlist, err := d.allowlistKeeper.GetAllowlist(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if allowlist.IsEmpty() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.checkMsgs(ctx, allowlist, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d WasmAllowlistDecorator) checkMsgs(ctx sdk.Context, allowlist wasmallowlist.Allowlist, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmTypes.MsgInstantiateContract:
+			if err := allowlist.CheckInstantiate(msg.CodeID); err != nil {
+				return err
+			}
+		case *wasmTypes.MsgInstantiateContract2:
+			if err := allowlist.CheckInstantiate(msg.CodeID); err != nil {
+				return err
+			}
+		case *wasmTypes.MsgExecuteContract:
+			if err := allowlist.CheckExecute(ctx, d.wasmKeeper, msg.Contract); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, allowlist, inner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
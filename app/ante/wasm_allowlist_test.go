@@ -0,0 +1,90 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eve-network/eve/app/wasmallowlist"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+type mockContractInfoKeeper map[string]uint64
+
+func (m mockContractInfoKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmTypes.ContractInfo {
+	codeID, ok := m[contractAddress.String()]
+	if !ok {
+		return nil
+	}
+	return &wasmTypes.ContractInfo{CodeID: codeID}
+}
+
+func TestWasmAllowlistDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	_, _, grantee := testdata.KeyTestPubAddr()
+	sender := grantee.String()
+	_, _, allowedContract := testdata.KeyTestPubAddr()
+	_, _, allowedCodeContract := testdata.KeyTestPubAddr()
+	_, _, otherContract := testdata.KeyTestPubAddr()
+	wasmKeeper := mockContractInfoKeeper{
+		allowedCodeContract.String(): 1,
+		otherContract.String():       2,
+	}
+
+	instantiate := func(codeID uint64) sdk.Msg {
+		return &wasmTypes.MsgInstantiateContract{Sender: sender, CodeID: codeID, Label: "test", Msg: []byte("{}")}
+	}
+	execute := func(contract sdk.AccAddress) sdk.Msg {
+		return &wasmTypes.MsgExecuteContract{Sender: sender, Contract: contract.String(), Msg: []byte("{}")}
+	}
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{execute(otherContract)})
+
+	allowlist := wasmallowlist.Allowlist{
+		AllowedCodeIDs:   []uint64{1},
+		AllowedContracts: []string{allowedContract.String()},
+	}
+	testCases := []struct {
+		name      string
+		allowlist wasmallowlist.Allowlist
+		msg       sdk.Msg
+		expErr    error
+	}{
+		{"empty allowlist, should pass", wasmallowlist.Allowlist{}, instantiate(2), nil},
+		{"allowed code id, should pass", allowlist, instantiate(1), nil},
+		{"disallowed code id, should fail", allowlist, instantiate(2), sdkerrors.ErrUnauthorized},
+		{"allowed contract, should pass", allowlist, execute(allowedContract), nil},
+		{"contract of allowed code id, should pass", allowlist, execute(allowedCodeContract), nil},
+		{"disallowed contract, should fail", allowlist, execute(otherContract), sdkerrors.ErrUnauthorized},
+		{"disallowed contract through authz, should fail", allowlist, &exec, sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(wasmallowlist.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			allowlistKeeper := wasmallowlist.NewKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(key), "")
+			require.NoError(t, allowlistKeeper.SetAllowlist(ctx, tc.allowlist))
+
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msg))
+			decorator := NewWasmAllowlistDecorator(allowlistKeeper, wasmKeeper)
+			_, err := decorator.AnteHandle(ctx, suite.txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
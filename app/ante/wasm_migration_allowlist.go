@@ -0,0 +1,67 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/eve-network/eve/app/wasmallowlist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// WasmMigrationAllowlistKeeper defines the keeper of the governance controlled
+// wasm migration allowlist.
+type WasmMigrationAllowlistKeeper interface {
+	GetMigrationAllowlist(ctx context.Context) (wasmallowlist.MigrationAllowlist, error)
+}
+
+// WasmMigrationAllowlistDecorator rejects the contract migrations sent by
+// addresses that are not on the wasm migration allowlist, including when
+// wrapped in an authz MsgExec, before the tx gets into the mempool. The app
+// checks the allowlist again where MsgMigrateContract is handled, which also
+// covers the migrations sent by contracts.
+type WasmMigrationAllowlistDecorator struct {
+	allowlistKeeper WasmMigrationAllowlistKeeper
+}
+
+func NewWasmMigrationAllowlistDecorator(allowlistKeeper WasmMigrationAllowlistKeeper) WasmMigrationAllowlistDecorator {
+	return WasmMigrationAllowlistDecorator{allowlistKeeper: allowlistKeeper}
+}
+
+func (d WasmMigrationAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	allowlist, err := d.allowlistKeeper.GetMigrationAllowlist(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if len(allowlist.AllowedMigrators) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := checkMigrators(allowlist, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func checkMigrators(allowlist wasmallowlist.MigrationAllowlist, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmTypes.MsgMigrateContract:
+			if err := allowlist.CheckMigrator(msg.Sender); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := checkMigrators(allowlist, inner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
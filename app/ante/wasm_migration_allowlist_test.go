@@ -0,0 +1,64 @@
+package ante
+
+import (
+	"testing"
+
+	"github.com/eve-network/eve/app/wasmallowlist"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestWasmMigrationAllowlistDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	_, _, allowed := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	_, _, contract := testdata.KeyTestPubAddr()
+
+	migrate := func(sender sdk.AccAddress) sdk.Msg {
+		return &wasmTypes.MsgMigrateContract{Sender: sender.String(), Contract: contract.String(), CodeID: 2, Msg: []byte("{}")}
+	}
+	exec := authz.NewMsgExec(allowed, []sdk.Msg{migrate(other)})
+
+	allowlist := wasmallowlist.MigrationAllowlist{AllowedMigrators: []string{allowed.String()}}
+	testCases := []struct {
+		name      string
+		allowlist wasmallowlist.MigrationAllowlist
+		msg       sdk.Msg
+		expErr    error
+	}{
+		{"empty allowlist, should pass", wasmallowlist.MigrationAllowlist{}, migrate(other), nil},
+		{"allowed migrator, should pass", allowlist, migrate(allowed), nil},
+		{"disallowed migrator, should fail", allowlist, migrate(other), sdkerrors.ErrUnauthorized},
+		{"disallowed migrator through authz, should fail", allowlist, &exec, sdkerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := storetypes.NewKVStoreKey(wasmallowlist.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			allowlistKeeper := wasmallowlist.NewKeeper(suite.encCfg.Codec, runtime.NewKVStoreService(key), "")
+			require.NoError(t, allowlistKeeper.SetMigrationAllowlist(ctx, tc.allowlist))
+
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msg))
+			decorator := NewWasmMigrationAllowlistDecorator(allowlistKeeper)
+			_, err := decorator.AnteHandle(ctx, suite.txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
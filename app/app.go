@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,11 +9,13 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8"
 	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
@@ -45,6 +48,10 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/eve-network/eve/app/ante"
+	"github.com/eve-network/eve/app/blocklist"
+	"github.com/eve-network/eve/app/feebypass"
+	"github.com/eve-network/eve/app/mingasprices"
+	"github.com/eve-network/eve/app/wasmallowlist"
 	feeabsmodule "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs"
 	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
 	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
@@ -58,6 +65,8 @@ import (
 	feemarketpost "github.com/skip-mev/feemarket/x/feemarket/post"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 	"github.com/spf13/cast"
+	"google.golang.org/grpc/health"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -161,6 +170,11 @@ import (
 
 const appName = "EveApp"
 
+// FlagGRPCCosmosReflection toggles the cosmos.reflection.v1 service on the
+// gRPC query router. It doesn't cover the gRPC server reflection and the
+// cosmos.base.reflection.v2alpha1 services, which the SDK always registers.
+const FlagGRPCCosmosReflection = "grpc.enable-cosmos-reflection"
+
 const (
 	// ContractMemoryLimit is the memory limit of each contract execution (in MiB)
 	// constant value so all nodes run with the same limit.
@@ -214,6 +228,32 @@ var maccPerms = map[string][]string{
 	feemarkettypes.FeeCollectorName: {authtypes.Burner},
 }
 
+// module accounts that are allowed to receive tokens
+var allowedReceivingModAcc = map[string]bool{
+	govtypes.ModuleName:    true,
+	feeabstypes.ModuleName: true,
+}
+
+// module accounts that collect tokens from accounts as part of the messages of
+// their module: fees, community pool deposits, burns and IBC escrows
+var collectingModAcc = map[string]bool{
+	authtypes.FeeCollectorName:      true,
+	feemarkettypes.FeeCollectorName: true,
+	distrtypes.ModuleName:           true,
+	ibctransfertypes.ModuleName:     true,
+	ibcfeetypes.ModuleName:          true,
+	wasmtypes.ModuleName:            true,
+	tokenfactorytypes.ModuleName:    true,
+}
+
+// module accounts that are not named after the module owning them
+var moduleAccountOwners = map[string]string{
+	authtypes.FeeCollectorName:      authtypes.ModuleName,
+	stakingtypes.BondedPoolName:     stakingtypes.ModuleName,
+	stakingtypes.NotBondedPoolName:  stakingtypes.ModuleName,
+	feemarkettypes.FeeCollectorName: feemarkettypes.ModuleName,
+}
+
 var (
 	_ runtime.AppI            = (*EveApp)(nil)
 	_ servertypes.Application = (*EveApp)(nil)
@@ -253,6 +293,10 @@ type EveApp struct {
 	CircuitKeeper         circuitkeeper.Keeper
 	FeeabsKeeper          feeabskeeper.Keeper
 	FeeMarketKeeper       *feemarketkeeper.Keeper
+	BlocklistKeeper       blocklist.Keeper
+	FeeBypassKeeper       feebypass.Keeper
+	MinGasPricesKeeper    mingasprices.Keeper
+	WasmAllowlistKeeper   wasmallowlist.Keeper
 
 	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	IBCFeeKeeper        ibcfeekeeper.Keeper
@@ -284,6 +328,14 @@ type EveApp struct {
 	// module configurator
 	configurator module.Configurator
 	once         sync.Once
+	// consensusParamsMigration records the outcome of the migration run once
+	consensusParamsMigration atomic.Pointer[ConsensusParamsMigration]
+
+	// healthServer reports SERVING once the latest version has been loaded
+	healthServer *health.Server
+
+	// wasm08VM is the VM running the 08-wasm light client contracts
+	wasm08VM *wasmvm.VM
 }
 
 // NewEveApp returns a reference to an initialized EveApp.
@@ -317,6 +369,15 @@ func NewEveApp(
 	std.RegisterLegacyAminoCodec(legacyAmino)
 	std.RegisterInterfaces(interfaceRegistry)
 
+	// the lane mempool replaces the default app-side mempool, if enabled
+	mempoolOpt, err := LaneMempoolOption(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if mempoolOpt != nil {
+		baseAppOptions = append(baseAppOptions, mempoolOpt)
+	}
+
 	bApp := baseapp.NewBaseApp(appName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetVersion(version.Version)
@@ -338,6 +399,7 @@ func NewEveApp(
 		icacontrollertypes.StoreKey, tokenfactorytypes.StoreKey,
 		ibchookstypes.StoreKey,
 		feeabstypes.StoreKey, feemarkettypes.StoreKey,
+		blocklist.StoreKey, feebypass.StoreKey, wasmallowlist.StoreKey, mingasprices.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -357,7 +419,9 @@ func NewEveApp(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		healthServer:      health.NewServer(),
 	}
+	app.healthServer.SetServingStatus("", healthgrpc.HealthCheckResponse_NOT_SERVING)
 
 	govModAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
@@ -410,6 +474,12 @@ func NewEveApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
 	)
+	app.BlocklistKeeper = blocklist.NewKeeper(
+		runtime.NewKVStoreService(keys[blocklist.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.BankKeeper.AppendSendRestriction(app.BlocklistKeeper.SendRestriction)
+	app.BankKeeper.AppendSendRestriction(moduleAccountSendRestriction())
 
 	app.StakingKeeper = *stakingkeeper.NewKeeper(
 		appCodec,
@@ -460,7 +530,8 @@ func NewEveApp(
 		app.AccountKeeper.AddressCodec(),
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	// the bank keeper refuses the grants creating the account of a blocked address
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper).SetBankKeeper(app.BankKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -481,7 +552,7 @@ func NewEveApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-	)
+	).SetBankKeeper(app.BankKeeper)
 
 	groupConfig := group.DefaultConfig()
 	/*
@@ -524,6 +595,7 @@ func NewEveApp(
 	if err != nil {
 		panic(err)
 	}
+	app.wasm08VM = wasmer
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
@@ -561,7 +633,7 @@ func NewEveApp(
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
-		AddRoute(feeabstypes.RouterKey, feeabsmodule.NewHostZoneProposal(app.FeeabsKeeper))
+		AddRoute(feeabstypes.RouterKey, app.hostZoneProposalHandler())
 
 	govConfig := govtypes.DefaultConfig()
 	/*
@@ -590,6 +662,16 @@ func NewEveApp(
 	)
 
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, keys[feemarkettypes.StoreKey], app.AccountKeeper, &feemarkettypes.TestDenomResolver{}, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.FeeBypassKeeper = feebypass.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[feebypass.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.MinGasPricesKeeper = mingasprices.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[mingasprices.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
 		keys[ibchookstypes.StoreKey],
@@ -654,41 +736,8 @@ func NewEveApp(
 	)
 
 	feeabsIBCModule := feeabsmodule.NewIBCModule(appCodec, app.FeeabsKeeper)
-	// Create Interchain Accounts Stack
-	// SendPacket, since it is originating from the application to core IBC:
-	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
-	var icaControllerStack porttypes.IBCModule
-	// integration point for custom authentication modules
-	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
-	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
-
-	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
-	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
-	var icaHostStack porttypes.IBCModule
-	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
-	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
-
-	// Transfer stack
-	var transferStack porttypes.IBCModule
-	transferStack = transfer.NewIBCModule(app.TransferKeeper)
-	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
-
-	// Create fee enabled wasm ibc Stack
-	var wasmStack porttypes.IBCModule
-	wasmStack = wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper)
-	wasmStack = ibcfee.NewIBCMiddleware(wasmStack, app.IBCFeeKeeper)
-
-	// Create static IBC router, add app routes, then set and seal it
-	ibcRouter := porttypes.NewRouter().
-		AddRoute(ibctransfertypes.ModuleName, transferStack).
-		AddRoute(wasmtypes.ModuleName, wasmStack).
-		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack).
-		AddRoute(feeabstypes.ModuleName, feeabsIBCModule)
-	app.IBCKeeper.SetRouter(ibcRouter)
 
+	// the ICA keepers must be created before the stacks below, which copy them
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
 		keys[icahosttypes.StoreKey],
@@ -715,10 +764,34 @@ func NewEveApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Create Interchain Accounts Stack
+	// SendPacket, since it is originating from the application to core IBC:
+	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
+	var icaControllerStack porttypes.IBCModule
+	// integration point for custom authentication modules
+	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
+	var noAuthzModule porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
+	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
+	var icaHostStack porttypes.IBCModule
+	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
+
+	// Transfer stack
+	// RecvPacket: channel.RecvPacket -> fee.OnRecvPacket -> memo limit -> transfer.OnRecvPacket
+	var transferStack porttypes.IBCModule
+	transferStack = NewWasmMemoLimitMiddleware(transfer.NewIBCModule(app.TransferKeeper), IBCHooksMaxMemoSize)
+	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
+
+	// the wasm keeper must be created before the wasm stack below, which copies it
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
+	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryHandlerDecorator(NewQueryGasCeilingDecorator(wasmConfig.SmartQueryGasLimit)))
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -742,6 +815,25 @@ func NewEveApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
+	app.WasmAllowlistKeeper = wasmallowlist.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[wasmallowlist.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Create fee enabled wasm ibc Stack
+	var wasmStack porttypes.IBCModule
+	wasmStack = NewWasmPacketSizeLimitMiddleware(wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper), WasmIBCMaxPacketSize)
+	wasmStack = ibcfee.NewIBCMiddleware(wasmStack, app.IBCFeeKeeper)
+
+	// Create static IBC router, add app routes, then set and seal it
+	ibcRouter := porttypes.NewRouter().
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(wasmtypes.ModuleName, wasmStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(feeabstypes.ModuleName, feeabsIBCModule)
+	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
 	// we prefer to be more strict in what arguments the modules expect.
@@ -790,7 +882,7 @@ func NewEveApp(
 		// non sdk modules
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		wasm08.NewAppModule(app.Wasm08Keeper),
-		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
+		newWasmAppModule(wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)), &app.WasmKeeper, app.GetSubspace(wasmtypes.ModuleName), app.WasmAllowlistKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
@@ -801,8 +893,12 @@ func NewEveApp(
 		// sdk
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them,
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
-		feeabsmodule.NewAppModule(appCodec, app.FeeabsKeeper),
+		newFeeabsAppModule(app, feeabsmodule.NewAppModule(appCodec, app.FeeabsKeeper)),
 		feemarket.NewAppModule(appCodec, *app.FeeMarketKeeper),
+		blocklist.NewAppModule(app.BlocklistKeeper),
+		feebypass.NewAppModule(app.FeeBypassKeeper),
+		mingasprices.NewAppModule(app.MinGasPricesKeeper),
+		wasmallowlist.NewAppModule(app.WasmAllowlistKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -824,6 +920,10 @@ func NewEveApp(
 			// wasm08types.ModuleName: wasm08.AppModuleBasic{},
 			// wasmtypes.ModuleName:   wasm.AppModuleBasic{},
 		})
+	if err := validateModuleAccounts(app.ModuleManager, maccPerms, allowedReceivingModAcc); err != nil {
+		panic(err)
+	}
+
 	app.BasicModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.BasicModuleManager.RegisterInterfaces(interfaceRegistry)
 
@@ -925,6 +1025,10 @@ func NewEveApp(
 
 		feemarkettypes.ModuleName,
 		feeabstypes.ModuleName,
+		blocklist.ModuleName,
+		feebypass.ModuleName,
+		mingasprices.ModuleName,
+		wasmallowlist.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -938,6 +1042,7 @@ func NewEveApp(
 	if err != nil {
 		panic(err)
 	}
+	RegisterQueryServer(app.GRPCQueryRouter(), queryServer{app: app})
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
@@ -945,11 +1050,13 @@ func NewEveApp(
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
-	reflectionSvc, err := runtimeservices.NewReflectionService()
-	if err != nil {
-		panic(err)
+	if reflectionEnabled := appOpts.Get(FlagGRPCCosmosReflection); reflectionEnabled == nil || cast.ToBool(reflectionEnabled) {
+		reflectionSvc, err := runtimeservices.NewReflectionService()
+		if err != nil {
+			panic(err)
+		}
+		reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 	}
-	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
@@ -977,11 +1084,16 @@ func NewEveApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	// set denom resolver to test variant.
-	app.FeeMarketKeeper.SetDenomResolver(&ante.DenomResolverImpl{
-		FeeabsKeeper:  app.FeeabsKeeper,
-		StakingKeeper: &app.StakingKeeper,
-	})
-	app.setAnteHandler(txConfig, wasmConfig, keys[wasmtypes.StoreKey])
+	denomResolver := &ante.DenomResolverImpl{
+		FeeabsKeeper:    app.FeeabsKeeper,
+		StakingKeeper:   &app.StakingKeeper,
+		FeeMarketKeeper: app.FeeMarketKeeper,
+	}
+	// the gas prices in the other fee denoms are raised to their floor, both by
+	// the fee market and by the fee checker used while it is disabled
+	floorResolver := ante.NewMinGasPriceFloorResolver(denomResolver, app.MinGasPricesKeeper)
+	app.FeeMarketKeeper.SetDenomResolver(floorResolver)
+	app.setAnteHandler(txConfig, wasmConfig, keys[wasmtypes.StoreKey], floorResolver)
 
 	// must be before Loading version
 	// requires the snapshot store to be created and registered as a BaseAppOption
@@ -1004,6 +1116,10 @@ func NewEveApp(
 	app.ScopedFeeabsKeeper = scopedFeeabsKeeper
 	app.ScopedIBCFeeKeeper = scopedIBCFeeKeeper
 
+	if err := validateKeeperWiring(app); err != nil {
+		panic(fmt.Sprintf("invalid keeper wiring: %s", err))
+	}
+
 	app.setPostHandler()
 
 	// At startup, after all modules have been registered, check that all proto
@@ -1029,10 +1145,12 @@ func NewEveApp(
 		if err := app.WasmKeeper.InitializePinnedCodes(ctx); err != nil {
 			panic(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
-		// if err := wasm08keeper.InitializePinnedCodes(ctx); err != nil {
-		// 	panic(fmt.Sprintf("failed initialize pinned codes %s", err))
-		// }
+		// Same for the 08-wasm light client codes, which are all pinned when stored
+		if err := wasm08keeper.InitializePinnedCodes(ctx); err != nil {
+			panic(fmt.Sprintf("failed initialize pinned light client codes %s", err))
+		}
 		app.CapabilityKeeper.Seal()
+		app.healthServer.SetServingStatus("", healthgrpc.HealthCheckResponse_SERVING)
 	}
 
 	return app
@@ -1041,25 +1159,46 @@ func NewEveApp(
 func (app *EveApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
 	// when skipping sdk 47 for sdk 50, the upgrade handler is called too late in BaseApp
 	// this is a hack to ensure that the migration is executed when needed and not panics
+	var migrationEvents []abci.Event
 	app.once.Do(func() {
 		ctx := app.NewUncachedContext(false, tmproto.Header{})
-		if _, err := app.ConsensusParamsKeeper.Params(ctx, &consensusparamtypes.QueryParamsRequest{}); err != nil {
-			// prevents panic: consensus key is nil: collections: not found: key 'no_key' of type github.com/cosmos/gogoproto/tendermint.types.ConsensusParams
-			// sdk 47:
-			// Migrate Tendermint consensus parameters from x/params module to a dedicated x/consensus module.
-			// see https://github.com/cosmos/cosmos-sdk/blob/v0.47.0/simapp/upgrades.go#L66
-			baseAppLegacySS := app.GetSubspace(baseapp.Paramspace)
-			err := baseapp.MigrateParams(sdk.UnwrapSDKContext(ctx), baseAppLegacySS, app.ConsensusParamsKeeper.ParamsStore)
-			if err != nil {
-				panic(err)
-			}
-		}
+		migrationEvents = app.runConsensusParamsMigration(ctx)
 	})
 
-	return app.BaseApp.FinalizeBlock(req)
+	res, err := app.BaseApp.FinalizeBlock(req)
+	if err != nil {
+		return res, err
+	}
+	res.Events = append(res.Events, migrationEvents...)
+	return res, nil
 }
 
-func (app *EveApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtypes.WasmConfig, txCounterStoreKey *storetypes.KVStoreKey) {
+// migrateConsensusParams moves the consensus params from the x/params baseapp
+// subspace to x/consensus when the x/consensus store is empty. It reports
+// whether params were migrated; once they exist it is a no-op, so calling it
+// again is safe.
+func (app *EveApp) migrateConsensusParams(ctx sdk.Context) (bool, error) {
+	if _, err := app.ConsensusParamsKeeper.Params(ctx, &consensusparamtypes.QueryParamsRequest{}); err == nil {
+		return false, nil
+	}
+
+	// prevents panic: consensus key is nil: collections: not found: key 'no_key' of type github.com/cosmos/gogoproto/tendermint.types.ConsensusParams
+	// sdk 47:
+	// Migrate Tendermint consensus parameters from x/params module to a dedicated x/consensus module.
+	// see https://github.com/cosmos/cosmos-sdk/blob/v0.47.0/simapp/upgrades.go#L66
+	baseAppLegacySS := app.GetSubspace(baseapp.Paramspace)
+	// MigrateParams would store empty consensus params when the legacy ones are undefined
+	if !baseAppLegacySS.Has(ctx, baseapp.ParamStoreKeyBlockParams) {
+		ctx.Logger().Info("warning: consensus parameters are undefined; skipping migration")
+		return false, nil
+	}
+	if err := baseapp.MigrateParams(ctx, baseAppLegacySS, app.ConsensusParamsKeeper.ParamsStore); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (app *EveApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtypes.WasmConfig, txCounterStoreKey *storetypes.KVStoreKey, denomResolver ante.NativeDenomResolver) {
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
 			HandlerOptions: authante.HandlerOptions{
@@ -1068,16 +1207,24 @@ func (app *EveApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtypes
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  authante.DefaultSigVerificationGasConsumer,
+				TxFeeChecker:    ante.NewFeeAbsTxFeeChecker(denomResolver),
 			},
-			FeeAbskeeper:          app.FeeabsKeeper,
-			IBCKeeper:             app.IBCKeeper,
-			WasmConfig:            &wasmConfig,
-			WasmKeeper:            &app.WasmKeeper,
-			TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
-			CircuitKeeper:         &app.CircuitKeeper,
-			FeeMarketKeeper:       app.FeeMarketKeeper,
-			AccountKeeper:         app.AccountKeeper,
-			BankKeeper:            app.BankKeeper,
+			FeeAbskeeper:                 app.FeeabsKeeper,
+			IBCKeeper:                    app.IBCKeeper,
+			WasmConfig:                   &wasmConfig,
+			WasmKeeper:                   &app.WasmKeeper,
+			TXCounterStoreService:        runtime.NewKVStoreService(txCounterStoreKey),
+			CircuitKeeper:                &app.CircuitKeeper,
+			FeeMarketKeeper:              app.FeeMarketKeeper,
+			AccountKeeper:                app.AccountKeeper,
+			BankKeeper:                   app.BankKeeper,
+			MaxMsgsPerTx:                 ante.DefaultMaxMsgsPerTx,
+			MaxGovMsgsPerTx:              ante.DefaultMaxGovMsgsPerTx,
+			WasmAllowlistKeeper:          app.WasmAllowlistKeeper,
+			WasmMigrationAllowlistKeeper: app.WasmAllowlistKeeper,
+			MsgRouter:                    app.MsgServiceRouter(),
+			DenomResolver:                denomResolver,
+			FeeBypassKeeper:              app.FeeBypassKeeper,
 		},
 	)
 	if err != nil {
@@ -1089,13 +1236,16 @@ func (app *EveApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtypes
 }
 
 func (app *EveApp) setPostHandler() {
-	postHandler := feemarketapp.PostHandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		FeeMarketKeeper: app.FeeMarketKeeper,
+	postHandler := PostHandlerOptions{
+		PostHandlerOptions: feemarketapp.PostHandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
+		},
+		FeeBypassKeeper: app.FeeBypassKeeper,
 	}
 	// Set the PostHandler for the app
-	sdkPostHandler, err := feemarketapp.NewPostHandler(postHandler)
+	sdkPostHandler, err := NewPostHandler(postHandler)
 	if err != nil {
 		panic(fmt.Errorf("failed to create PostHandler: %s", err))
 	}
@@ -1257,6 +1407,11 @@ func (app *EveApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register grpc-gateway routes for the queries of the app.
+	if err := RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -1283,6 +1438,13 @@ func (app *EveApp) RegisterNodeService(clientCtx client.Context, cfg config.Conf
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
 }
 
+// RegisterGRPCServer registers the query router services and the standard gRPC
+// health service with the gRPC server.
+func (app *EveApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	healthgrpc.RegisterHealthServer(server, app.healthServer)
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -1299,16 +1461,60 @@ func GetMaccPerms() map[string][]string {
 func BlockedAddresses() map[string]bool {
 	modAccAddrs := make(map[string]bool)
 	for acc := range GetMaccPerms() {
+		// module accounts listed in allowedReceivingModAcc are allowed to receive funds
+		if allowedReceivingModAcc[acc] {
+			continue
+		}
 		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
-	// allow the following addresses to receive funds
-	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
-	delete(modAccAddrs, authtypes.NewModuleAddress(feeabstypes.ModuleName).String())
-
 	return modAccAddrs
 }
 
+// moduleAccountSendRestriction returns a bank send restriction rejecting the
+// transfers from accounts into the module accounts which are neither in
+// allowedReceivingModAcc nor in collectingModAcc. Unlike the blocked addresses,
+// which only the bank Msg service checks, it also covers the transfers made by
+// the other modules, such as a tokenfactory force transfer. The transfers
+// between module accounts are let through.
+func moduleAccountSendRestriction() banktypes.SendRestrictionFn {
+	moduleAddrs := make(map[string]bool, len(maccPerms))
+	restricted := make(map[string]bool, len(maccPerms))
+	for acc := range maccPerms {
+		addr := string(authtypes.NewModuleAddress(acc))
+		moduleAddrs[addr] = true
+		restricted[addr] = !allowedReceivingModAcc[acc] && !collectingModAcc[acc]
+	}
+
+	return func(_ context.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if !restricted[string(toAddr)] || moduleAddrs[string(fromAddr)] {
+			return toAddr, nil
+		}
+		return toAddr, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s is not allowed to receive funds", toAddr)
+	}
+}
+
+// validateModuleAccounts checks that every module account with permissions
+// belongs to a module of the manager, and that the accounts allowed to receive
+// funds all have permissions declared.
+func validateModuleAccounts(mm *module.Manager, perms map[string][]string, allowedReceiving map[string]bool) error {
+	for acc := range perms {
+		owner := acc
+		if name, ok := moduleAccountOwners[acc]; ok {
+			owner = name
+		}
+		if _, ok := mm.Modules[owner]; !ok {
+			return fmt.Errorf("module account %s has permissions but module %s is not registered", acc, owner)
+		}
+	}
+	for acc := range allowedReceiving {
+		if _, ok := perms[acc]; !ok {
+			return fmt.Errorf("module account %s is allowed to receive funds but has no permissions declared", acc)
+		}
+	}
+	return nil
+}
+
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -1337,8 +1543,16 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	return paramsKeeper
 }
 
-// NewPostHandler returns a PostHandler chain with the fee deduct decorator.
-func NewPostHandler(options feemarketapp.PostHandlerOptions) (sdk.PostHandler, error) {
+// PostHandlerOptions are the fee market post handler options along with the
+// fee bypass keeper.
+type PostHandlerOptions struct {
+	feemarketapp.PostHandlerOptions
+
+	FeeBypassKeeper ante.FeeBypassKeeper
+}
+
+// NewPostHandler returns a PostHandler chain with the fee deduct and fee analytics decorators.
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for post builder")
 	}
@@ -1351,12 +1565,20 @@ func NewPostHandler(options feemarketapp.PostHandlerOptions) (sdk.PostHandler, e
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for post builder")
 	}
 
+	if options.FeeBypassKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "fee bypass keeper is required for post builder")
+	}
+
 	postDecorators := []sdk.PostDecorator{
-		feemarketpost.NewFeeMarketDeductDecorator(
-			options.AccountKeeper,
-			options.BankKeeper,
-			options.FeeMarketKeeper,
+		NewFeeBypassPostDecorator(
+			options.FeeBypassKeeper,
+			feemarketpost.NewFeeMarketDeductDecorator(
+				options.AccountKeeper,
+				options.BankKeeper,
+				options.FeeMarketKeeper,
+			),
 		),
+		NewFeeAnalyticsDecorator(),
 	}
 
 	return sdk.ChainPostDecorators(postDecorators...), nil
@@ -0,0 +1,304 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	wasm08types "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/eve-network/eve/app/ante"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestAllowedReceivingModAcc(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addrs := AddTestAddrsIncremental(app, ctx, 2, sdkmath.NewInt(1_000_000))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	msgServer := bankkeeper.NewMsgServerImpl(app.BankKeeper)
+
+	// the bank Msg service rejects the sends to the blocked addresses, the send
+	// restriction rejects the keeper transfers too, except into the module
+	// accounts collecting funds from accounts
+	testCases := []struct {
+		name         string
+		to           sdk.AccAddress
+		expMsgErr    bool
+		expKeeperErr bool
+	}{
+		{"allowed module account", authtypes.NewModuleAddress(govtypes.ModuleName), false, false},
+		{"blocked module account", authtypes.NewModuleAddress(minttypes.ModuleName), true, true},
+		{"collecting module account", authtypes.NewModuleAddress(distrtypes.ModuleName), true, false},
+		{"user account", addrs[1], false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.Send(ctx, banktypes.NewMsgSend(addrs[0], tc.to, coins))
+			if tc.expMsgErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			err = app.BankKeeper.SendCoins(ctx, addrs[0], tc.to, coins)
+			if tc.expKeeperErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// the module accounts still move funds between them
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, minttypes.ModuleName, coins))
+}
+
+func TestGRPCHealthService(t *testing.T) {
+	app := Setup(t)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(app.InterfaceRegistry()).GRPCCodec()))
+	app.RegisterGRPCServer(grpcSrv)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	resp, err := healthgrpc.NewHealthClient(conn).Check(context.Background(), &healthgrpc.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthgrpc.HealthCheckResponse_SERVING, resp.Status)
+}
+
+func TestNativeFeeDenom(t *testing.T) {
+	app := Setup(t)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	params, err := app.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.FeeDenom = "ufee"
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+
+	_, err = app.FeeMarketKeeper.ResolveToDenom(ctx, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(100)), "ufee")
+	require.EqualError(t, err, ante.ErrDenomNotRegistered("ibcfee").Error())
+
+	require.NoError(t, app.FeeabsKeeper.SetHostZoneConfig(ctx, feeabstypes.HostChainFeeAbsConfig{
+		IbcDenom:                "ibcfee",
+		OsmosisPoolTokenDenomIn: "ibc/osmo",
+		PoolId:                  1,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+	}))
+	app.FeeabsKeeper.SetTwapRate(ctx, "ibcfee", sdkmath.LegacyNewDec(2))
+
+	// gas prices are converted to and from the fee denom of the fee market
+	// params at the twap rate
+	converted, err := app.FeeMarketKeeper.ResolveToDenom(ctx, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(100)), "ufee")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("ufee", sdkmath.NewInt(50)), converted)
+	converted, err = app.FeeMarketKeeper.ResolveToDenom(ctx, sdk.NewDecCoin("ufee", sdkmath.NewInt(50)), "ibcfee")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(100)), converted)
+
+	// the bond denom no longer is
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	_, err = app.FeeMarketKeeper.ResolveToDenom(ctx, sdk.NewDecCoin("ibcfee", sdkmath.NewInt(100)), bondDenom)
+	require.EqualError(t, err, ante.ErrNeitherNativeDenom("ibcfee", bondDenom).Error())
+}
+
+func TestMigrateConsensusParams(t *testing.T) {
+	t.Run("populated store is left untouched", func(t *testing.T) {
+		app := Setup(t)
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+		params, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+		require.NoError(t, err)
+
+		migrated, err := app.migrateConsensusParams(ctx)
+		require.NoError(t, err)
+		require.False(t, migrated)
+
+		got, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, params, got)
+	})
+
+	t.Run("empty store migrates legacy params once", func(t *testing.T) {
+		app := SetupWithEmptyStore(t)
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+		_, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+		require.Error(t, err)
+
+		legacy := cmttypes.DefaultConsensusParams().ToProto()
+		subspace := app.GetSubspace(baseapp.Paramspace)
+		subspace.Set(ctx, baseapp.ParamStoreKeyBlockParams, *legacy.Block)
+		subspace.Set(ctx, baseapp.ParamStoreKeyEvidenceParams, *legacy.Evidence)
+		subspace.Set(ctx, baseapp.ParamStoreKeyValidatorParams, *legacy.Validator)
+
+		migrated, err := app.migrateConsensusParams(ctx)
+		require.NoError(t, err)
+		require.True(t, migrated)
+
+		got, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, legacy.Block, got.Block)
+		require.Equal(t, legacy.Evidence, got.Evidence)
+		require.Equal(t, legacy.Validator, got.Validator)
+
+		migrated, err = app.migrateConsensusParams(ctx)
+		require.NoError(t, err)
+		require.False(t, migrated)
+	})
+
+	t.Run("empty store without legacy params is skipped", func(t *testing.T) {
+		app := SetupWithEmptyStore(t)
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+
+		migrated, err := app.migrateConsensusParams(ctx)
+		require.NoError(t, err)
+		require.False(t, migrated)
+	})
+}
+
+func TestConsensusParamsMigrationObservability(t *testing.T) {
+	newApp := func(t *testing.T, logs *bytes.Buffer) *EveApp {
+		t.Helper()
+		return NewWasmAppWithCustomOptions(t, false, SetupOptions{
+			Logger:  log.NewLogger(logs, log.ColorOption(false)),
+			DB:      dbm.NewMemDB(),
+			AppOpts: simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()},
+		})
+	}
+	migrationEvent := func(events []abci.Event) bool {
+		return slices.ContainsFunc(events, func(event abci.Event) bool {
+			return event.Type == EventTypeConsensusParamsMigration
+		})
+	}
+
+	t.Run("migration runs", func(t *testing.T) {
+		var logs bytes.Buffer
+		app := newApp(t, &logs)
+		require.Equal(t, ConsensusParamsMigration{}, app.ConsensusParamsMigration())
+
+		// move the consensus params back to x/params, as before sdk 47
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+		require.NoError(t, app.ConsensusParamsKeeper.ParamsStore.Remove(ctx))
+		legacy := cmttypes.DefaultConsensusParams().ToProto()
+		subspace := app.GetSubspace(baseapp.Paramspace)
+		subspace.Set(ctx, baseapp.ParamStoreKeyBlockParams, *legacy.Block)
+		subspace.Set(ctx, baseapp.ParamStoreKeyEvidenceParams, *legacy.Evidence)
+		subspace.Set(ctx, baseapp.ParamStoreKeyValidatorParams, *legacy.Validator)
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		require.True(t, migrationEvent(res.Events))
+		require.Contains(t, logs.String(), "consensus params migration checked migrated=true")
+		require.Equal(t, ConsensusParamsMigration{Checked: true, Migrated: true}, app.ConsensusParamsMigration())
+
+		_, err = app.Commit()
+		require.NoError(t, err)
+		res, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2})
+		require.NoError(t, err)
+		require.False(t, migrationEvent(res.Events))
+	})
+
+	t.Run("migration is not needed", func(t *testing.T) {
+		var logs bytes.Buffer
+		app := newApp(t, &logs)
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		require.False(t, migrationEvent(res.Events))
+		require.Contains(t, logs.String(), "consensus params migration checked migrated=false")
+		require.Equal(t, ConsensusParamsMigration{Checked: true}, app.ConsensusParamsMigration())
+
+		// the outcome is served once the block is committed
+		_, err = app.Commit()
+		require.NoError(t, err)
+		var queryRes QueryConsensusParamsMigrationResponse
+		queryApp(t, app, "ConsensusParamsMigration", &QueryConsensusParamsMigrationRequest{}, &queryRes)
+		require.Equal(t, ConsensusParamsMigration{Checked: true}, queryRes.Migration)
+	})
+}
+
+func TestInitializePinnedLightClientCodes(t *testing.T) {
+	db := dbm.NewMemDB()
+	home := t.TempDir()
+	app := NewWasmAppWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      db,
+		AppOpts: simtestutil.AppOptionsMap{flags.FlagHome: home},
+	})
+
+	code, err := os.ReadFile(filepath.Join("testdata", "hackatom.wasm.gzip"))
+	require.NoError(t, err)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	_, err = app.Wasm08Keeper.StoreCode(ctx, &wasm08types.MsgStoreCode{
+		Signer:       app.Wasm08Keeper.GetAuthority(),
+		WasmByteCode: code,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	metrics, err := app.wasm08VM.GetMetrics()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), metrics.ElementsPinnedMemoryCache)
+
+	// restart on the same state with a copy of the compiled code, as the
+	// running VM holds a lock on its directory
+	restartedHome := t.TempDir()
+	require.NoError(t, os.CopyFS(filepath.Join(restartedHome, "wasm"), os.DirFS(filepath.Join(home, "wasm"))))
+	restarted := NewEveApp(log.NewNopLogger(), db, nil, true, simtestutil.AppOptionsMap{flags.FlagHome: restartedHome}, nil)
+
+	metrics, err = restarted.wasm08VM.GetMetrics()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), metrics.ElementsPinnedMemoryCache)
+}
+
+func TestValidateModuleAccounts(t *testing.T) {
+	app := Setup(t)
+	require.NoError(t, validateModuleAccounts(app.ModuleManager, GetMaccPerms(), allowedReceivingModAcc))
+
+	perms := GetMaccPerms()
+	perms["bogus"] = []string{authtypes.Burner}
+	require.ErrorContains(t, validateModuleAccounts(app.ModuleManager, perms, allowedReceivingModAcc), "module bogus is not registered")
+
+	allowed := map[string]bool{"bogus": true}
+	require.ErrorContains(t, validateModuleAccounts(app.ModuleManager, GetMaccPerms(), allowed), "bogus is allowed to receive funds")
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ExportBalancesAtHeight returns the balances of every account at the given
+// committed height, or at the latest one when height is 0, sorted by address.
+// Delegated tokens are added to the liquid balance of the delegator in the
+// bond denom, and module accounts are left out so the tokens held by the
+// staking pools aren't counted twice. With excludeSelfDelegations, the tokens
+// validators delegated to themselves from their operator account are left out
+// too. The export fails when the balances don't reconcile with the supply,
+// see reconcileBalances. The height must not be pruned.
+func (app *EveApp) ExportBalancesAtHeight(height int64, excludeSelfDelegations bool) ([]banktypes.Balance, error) {
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddrs := make(map[string]bool, len(maccPerms))
+	for name := range maccPerms {
+		moduleAddrs[authtypes.NewModuleAddress(name).String()] = true
+	}
+
+	balances := make(map[string]sdk.Coins)
+	liquid, moduleHeld := sdk.NewCoins(), sdk.NewCoins()
+	app.BankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if moduleAddrs[addr.String()] {
+			moduleHeld = moduleHeld.Add(coin)
+			return false
+		}
+		balances[addr.String()] = balances[addr.String()].Add(coin)
+		liquid = liquid.Add(coin)
+		return false
+	})
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	staked := sdkmath.ZeroInt()
+	var iterErr error
+	err = app.StakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		// the operator account has the same bytes as the operator address
+		if excludeSelfDelegations && sdk.AccAddress(valAddr).String() == delegation.DelegatorAddress {
+			return false
+		}
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		if tokens.IsPositive() {
+			balances[delegation.DelegatorAddress] = balances[delegation.DelegatorAddress].Add(sdk.NewCoin(bondDenom, tokens))
+			staked = staked.Add(tokens)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if err := app.reconcileBalances(ctx, liquid, moduleHeld, sdk.NewCoin(bondDenom, staked)); err != nil {
+		return nil, err
+	}
+
+	result := make([]banktypes.Balance, 0, len(balances))
+	for addr, coins := range balances {
+		result = append(result, banktypes.Balance{Address: addr, Coins: coins})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Address < result[j].Address
+	})
+	return result, nil
+}
+
+// reconcileBalances checks the exported balances against the supply: the
+// liquid balances and the balances of the module accounts must add up to the
+// total supply, and the delegated tokens can't exceed the balances of the
+// staking pools holding them.
+func (app *EveApp) reconcileBalances(ctx sdk.Context, liquid, moduleHeld sdk.Coins, staked sdk.Coin) error {
+	supply := sdk.NewCoins()
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+	if held := liquid.Add(moduleHeld...); !held.Equal(supply) {
+		return fmt.Errorf("balances %s don't add up to the total supply %s", held, supply)
+	}
+
+	pooled := app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(stakingtypes.BondedPoolName), staked.Denom).
+		Add(app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName), staked.Denom))
+	if pooled.IsLT(staked) {
+		return fmt.Errorf("delegated tokens %s exceed the staking pools balance %s", staked, pooled)
+	}
+	return nil
+}
+
+// BalanceDelta is the change of the balance of an address between two exports.
+type BalanceDelta struct {
+	Address string    `json:"address"`
+	Old     sdk.Coins `json:"old"`
+	New     sdk.Coins `json:"new"`
+}
+
+// AllocationsDiff is the difference between two balance exports, each list
+// sorted by address.
+type AllocationsDiff struct {
+	Added   []banktypes.Balance `json:"added"`
+	Removed []banktypes.Balance `json:"removed"`
+	Changed []BalanceDelta      `json:"changed"`
+}
+
+// DiffAllocations returns the addresses only in the new export, the ones only
+// in the old export and the ones whose balance changed between the two.
+func DiffAllocations(oldBalances, newBalances []banktypes.Balance) AllocationsDiff {
+	diff := AllocationsDiff{Added: []banktypes.Balance{}, Removed: []banktypes.Balance{}, Changed: []BalanceDelta{}}
+	oldCoins := make(map[string]sdk.Coins, len(oldBalances))
+	for _, balance := range oldBalances {
+		oldCoins[balance.Address] = oldCoins[balance.Address].Add(balance.Coins...)
+	}
+	newCoins := make(map[string]sdk.Coins, len(newBalances))
+	for _, balance := range newBalances {
+		newCoins[balance.Address] = newCoins[balance.Address].Add(balance.Coins...)
+	}
+
+	for addr, coins := range newCoins {
+		prev, found := oldCoins[addr]
+		switch {
+		case !found:
+			diff.Added = append(diff.Added, banktypes.Balance{Address: addr, Coins: coins})
+		case !prev.Equal(coins):
+			diff.Changed = append(diff.Changed, BalanceDelta{Address: addr, Old: prev, New: coins})
+		}
+	}
+	for addr, coins := range oldCoins {
+		if _, found := newCoins[addr]; !found {
+			diff.Removed = append(diff.Removed, banktypes.Balance{Address: addr, Coins: coins})
+		}
+	}
+
+	sort.Slice(diff.Added, func(i, j int) bool { return diff.Added[i].Address < diff.Added[j].Address })
+	sort.Slice(diff.Removed, func(i, j int) bool { return diff.Removed[i].Address < diff.Removed[j].Address })
+	sort.Slice(diff.Changed, func(i, j int) bool { return diff.Changed[i].Address < diff.Changed[j].Address })
+	return diff
+}
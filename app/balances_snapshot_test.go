@@ -0,0 +1,267 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestExportBalancesAtHeight(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	nextBlock := func(mutate func(ctx sdk.Context)) int64 {
+		mutate(app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1}))
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+		return app.LastBlockHeight()
+	}
+	bondDenom := sdk.DefaultBondDenom
+
+	var addrs []sdk.AccAddress
+	funded := nextBlock(func(ctx sdk.Context) {
+		addrs = AddTestAddrsIncremental(app, ctx, 2, sdkmath.NewInt(1_000_000))
+	})
+	moved := nextBlock(func(ctx sdk.Context) {
+		validators, err := app.StakingKeeper.GetAllValidators(ctx)
+		require.NoError(t, err)
+		_, err = app.StakingKeeper.Delegate(ctx, addrs[0], sdkmath.NewInt(400_000), stakingtypes.Unbonded, validators[0], true)
+		require.NoError(t, err)
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], addrs[0], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 250_000))))
+	})
+
+	balancesOf := func(height int64) map[string]sdk.Coins {
+		balances, err := app.ExportBalancesAtHeight(height, false)
+		require.NoError(t, err)
+		byAddr := make(map[string]sdk.Coins, len(balances))
+		for i, balance := range balances {
+			if i > 0 {
+				require.Less(t, balances[i-1].Address, balance.Address)
+			}
+			byAddr[balance.Address] = balance.Coins
+		}
+		require.NotContains(t, byAddr, authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String())
+		return byAddr
+	}
+
+	atFunded := balancesOf(funded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), atFunded[addrs[0].String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), atFunded[addrs[1].String()])
+
+	// the delegated tokens are counted along with the liquid ones
+	atMoved := balancesOf(moved)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_250_000)), atMoved[addrs[0].String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 750_000)), atMoved[addrs[1].String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 850_000)), app.BankKeeper.GetAllBalances(app.BaseApp.NewContext(true), addrs[0]))
+
+	require.Equal(t, atMoved, balancesOf(0))
+}
+
+func TestExportBalancesExcludeSelfDelegations(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	bondDenom := sdk.DefaultBondDenom
+
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	operator := sdk.AccAddress(valAddr)
+	delegator := AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))[0]
+	initAccountWithCoins(app, ctx, operator, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)))
+	_, err = app.StakingKeeper.Delegate(ctx, operator, sdkmath.NewInt(300_000), stakingtypes.Unbonded, validators[0], true)
+	require.NoError(t, err)
+	validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, delegator, sdkmath.NewInt(400_000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	balancesOf := func(excludeSelfDelegations bool) map[string]sdk.Coins {
+		balances, err := app.ExportBalancesAtHeight(0, excludeSelfDelegations)
+		require.NoError(t, err)
+		byAddr := make(map[string]sdk.Coins, len(balances))
+		for _, balance := range balances {
+			byAddr[balance.Address] = balance.Coins
+		}
+		return byAddr
+	}
+
+	all := balancesOf(false)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), all[operator.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), all[delegator.String()])
+
+	// only the liquid tokens of the operator are left
+	external := balancesOf(true)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700_000)), external[operator.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), external[delegator.String()])
+}
+
+func TestExportBalancesReconciliation(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))
+	commit := func() {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+	commit()
+	_, err := app.ExportBalancesAtHeight(0, false)
+	require.NoError(t, err)
+
+	// a supply the balances don't add up to fails the export
+	ctx = app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1})
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	require.NoError(t, app.BankKeeper.(bankkeeper.BaseKeeper).Supply.Set(ctx, supply.Denom, supply.Amount.AddRaw(1)))
+	commit()
+	_, err = app.ExportBalancesAtHeight(0, false)
+	require.ErrorContains(t, err, "don't add up to the total supply")
+}
+
+func TestExportedBalancesGenesisImport(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addrs := AddTestAddrsIncremental(app, ctx, 2, sdkmath.NewInt(1_000_000))
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[0], sdkmath.NewInt(400_000), stakingtypes.Unbonded, validators[0], true)
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	balances, err := app.ExportBalancesAtHeight(0, false)
+	require.NoError(t, err)
+
+	// the export reconciles with the supply: everything but the module
+	// accounts, plus the delegated tokens held by the staking pools
+	ctx = app.BaseApp.NewContext(true)
+	exported := sdk.NewCoins()
+	for _, balance := range balances {
+		exported = exported.Add(balance.Coins...)
+	}
+	expected := sdk.NewCoins()
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		expected = expected.Add(coin)
+		return false
+	})
+	for name := range maccPerms {
+		expected = expected.Sub(app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(name))...)
+	}
+	delegations, err := app.StakingKeeper.GetAllDelegations(ctx)
+	require.NoError(t, err)
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	for _, delegation := range delegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		require.NoError(t, err)
+		validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		expected = expected.Add(sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt()))
+	}
+	require.Equal(t, expected, exported)
+
+	// airdrop the exported balances in the genesis of a new chain, leaving the
+	// supply to be derived from the balances by the bank genesis
+	imported := SetupWithEmptyStore(t)
+	genesisState := GenesisStateWithSingleValidator(t, imported)
+	var bankGenesis banktypes.GenesisState
+	imported.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, balances...)
+	bankGenesis.Supply = nil
+	genesisState[banktypes.ModuleName] = imported.AppCodec().MustMarshalJSON(&bankGenesis)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	_, err = imported.InitChain(&abci.RequestInitChain{
+		ChainId:         "testing",
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	importedCtx := imported.BaseApp.NewContext(false)
+	msg, broken := bankkeeper.TotalSupply(imported.BankKeeper)(importedCtx)
+	require.False(t, broken, msg)
+	for _, coin := range exported {
+		require.True(t, imported.BankKeeper.GetSupply(importedCtx, coin.Denom).IsGTE(coin))
+	}
+	for _, balance := range balances {
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		require.NoError(t, err)
+		require.Equal(t, balance.Coins, imported.BankKeeper.GetAllBalances(importedCtx, addr))
+	}
+}
+
+func TestDiffAllocations(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	oldBalances := []banktypes.Balance{
+		{Address: "eve1a", Coins: coins(100)},
+		{Address: "eve1b", Coins: coins(200)},
+		{Address: "eve1c", Coins: coins(300)},
+	}
+
+	testCases := []struct {
+		name        string
+		newBalances []banktypes.Balance
+		exp         AllocationsDiff
+	}{
+		{
+			name:        "same snapshot",
+			newBalances: oldBalances,
+			exp:         AllocationsDiff{Added: []banktypes.Balance{}, Removed: []banktypes.Balance{}, Changed: []BalanceDelta{}},
+		},
+		{
+			name: "overlapping snapshots",
+			newBalances: []banktypes.Balance{
+				{Address: "eve1d", Coins: coins(400)},
+				{Address: "eve1c", Coins: coins(300)},
+				{Address: "eve1a", Coins: coins(150)},
+			},
+			exp: AllocationsDiff{
+				Added:   []banktypes.Balance{{Address: "eve1d", Coins: coins(400)}},
+				Removed: []banktypes.Balance{{Address: "eve1b", Coins: coins(200)}},
+				Changed: []BalanceDelta{{Address: "eve1a", Old: coins(100), New: coins(150)}},
+			},
+		},
+		{
+			name: "disjoint snapshots",
+			newBalances: []banktypes.Balance{
+				{Address: "eve1e", Coins: coins(500)},
+				{Address: "eve1d", Coins: coins(400)},
+			},
+			exp: AllocationsDiff{
+				Added:   []banktypes.Balance{{Address: "eve1d", Coins: coins(400)}, {Address: "eve1e", Coins: coins(500)}},
+				Removed: oldBalances,
+				Changed: []BalanceDelta{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, DiffAllocations(oldBalances, tc.newBalances))
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package blocklist
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the blocklist messages.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateBlocklist{})
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,53 @@
+package blocklist
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns the default blocklist genesis state, with no
+// blocked address.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate checks that the blocked addresses are valid and unique.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.BlockedAddresses))
+	for _, address := range gs.BlockedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate blocked address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+// InitGenesis blocks the addresses of the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs GenesisState) error {
+	for _, address := range gs.BlockedAddresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if err := k.Block(ctx, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the blocked addresses as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) *GenesisState {
+	addrs := k.GetBlockedAddresses(ctx)
+	gs := &GenesisState{BlockedAddresses: make([]string, len(addrs))}
+	for i, addr := range addrs {
+		gs.BlockedAddresses[i] = addr.String()
+	}
+	return gs
+}
@@ -0,0 +1,43 @@
+package blocklist
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns the blocklist Query service implementation.
+func NewQueryServerImpl(keeper Keeper) QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// BlockedAddresses returns a page of the blocked addresses.
+func (k queryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), BlockedAddressPrefix)
+	var addrs []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addrs = append(addrs, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &QueryBlockedAddressesResponse{BlockedAddresses: addrs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,95 @@
+package blocklist
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ModuleName is the name of the blocklist module.
+	ModuleName = "blocklist"
+
+	// StoreKey is the key of the store holding the blocked addresses.
+	StoreKey = ModuleName
+)
+
+// BlockedAddressPrefix prefixes the keys of the blocked addresses.
+var BlockedAddressPrefix = []byte{0x01}
+
+// BlockedAddressKey returns the store key of a blocked address.
+func BlockedAddressKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, BlockedAddressPrefix...), addr...)
+}
+
+// Keeper manages a governance controlled list of addresses that can't
+// receive funds, on top of the module accounts blocked by the bank keeper.
+type Keeper struct {
+	storeService corestore.KVStoreService
+	authority    string
+}
+
+// NewKeeper returns the blocklist keeper. Only authority can update the list.
+func NewKeeper(storeService corestore.KVStoreService, authority string) Keeper {
+	return Keeper{
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the blocklist.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsBlocked reports whether addr is on the blocklist.
+func (k Keeper) IsBlocked(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(BlockedAddressKey(addr))
+}
+
+// Block adds addr to the blocklist.
+func (k Keeper) Block(ctx context.Context, addr sdk.AccAddress) error {
+	return k.storeService.OpenKVStore(ctx).Set(BlockedAddressKey(addr), []byte{})
+}
+
+// Unblock removes addr from the blocklist.
+func (k Keeper) Unblock(ctx context.Context, addr sdk.AccAddress) error {
+	return k.storeService.OpenKVStore(ctx).Delete(BlockedAddressKey(addr))
+}
+
+// GetBlockedAddresses returns the blocked addresses in store order.
+func (k Keeper) GetBlockedAddresses(ctx context.Context) []sdk.AccAddress {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, BlockedAddressPrefix)
+	defer iterator.Close()
+
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, sdk.AccAddress(iterator.Key()[len(BlockedAddressPrefix):]))
+	}
+	return addrs
+}
+
+// SendRestriction is a bank send restriction rejecting the transfers to the
+// blocked addresses, including the payouts of module accounts such as IBC
+// vouchers and staking rewards. Only the transfers from the governance module
+// account are let through: the gov EndBlocker refunds the proposal deposits
+// and must not fail because of a depositor.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	blocked, err := k.IsBlocked(ctx, toAddr)
+	if err != nil || !blocked {
+		return toAddr, err
+	}
+	if fromAddr.Equals(authtypes.NewModuleAddress(govtypes.ModuleName)) {
+		return toAddr, nil
+	}
+	return toAddr, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+}
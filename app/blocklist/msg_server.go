@@ -0,0 +1,71 @@
+package blocklist
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns the blocklist Msg service implementation.
+func NewMsgServerImpl(keeper Keeper) MsgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// UpdateBlocklist adds the addresses of msg.Add to the blocklist, then
+// removes the ones of msg.Remove.
+func (k msgServer) UpdateBlocklist(goCtx context.Context, msg *MsgUpdateBlocklist) (*MsgUpdateBlocklistResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no address to add or remove")
+	}
+	add, err := parseAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+	remove, err := parseAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, addr := range add {
+		if err := k.Block(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	for _, addr := range remove {
+		if err := k.Unblock(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgUpdateBlocklistResponse{}, nil
+}
+
+func parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	seen := make(map[string]bool, len(addresses))
+	addrs := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", address, err)
+		}
+		if seen[address] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", address)
+		}
+		seen[address] = true
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/blocklist/v1/query.proto
+
+package blocklist
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlockedAddressesRequest is the Query/BlockedAddresses request type.
+type QueryBlockedAddressesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53db6324ede26669, []int{0}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAddressesResponse is the Query/BlockedAddresses response type.
+type QueryBlockedAddressesResponse struct {
+	// blocked_addresses lists the blocked addresses of the page.
+	BlockedAddresses []string `protobuf:"bytes,1,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53db6324ede26669, []int{1}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "eve.blocklist.v1.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "eve.blocklist.v1.QueryBlockedAddressesResponse")
+}
+
+func init() { proto.RegisterFile("eve/blocklist/v1/query.proto", fileDescriptor_53db6324ede26669) }
+
+var fileDescriptor_53db6324ede26669 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x4e, 0x2a, 0x41,
+	0x14, 0xc6, 0x19, 0x6e, 0xee, 0x4d, 0xee, 0xdc, 0x86, 0xbb, 0xb1, 0x40, 0x82, 0x1b, 0x82, 0x51,
+	0x89, 0x86, 0x99, 0x2c, 0x3e, 0x81, 0x9b, 0xa8, 0xad, 0x62, 0x67, 0x43, 0x66, 0xe0, 0xb8, 0x6e,
+	0x80, 0x99, 0x65, 0x67, 0x58, 0x63, 0xeb, 0x13, 0x98, 0xf8, 0x02, 0xd6, 0x36, 0x36, 0x3e, 0x84,
+	0x25, 0xd1, 0xc6, 0xd2, 0x80, 0x0f, 0x62, 0x76, 0x67, 0x14, 0x84, 0xf8, 0xa7, 0x9c, 0x7c, 0xe7,
+	0xfb, 0xbe, 0xdf, 0x9e, 0x3d, 0xb8, 0x0c, 0x09, 0x50, 0xde, 0x93, 0xed, 0x6e, 0x2f, 0x54, 0x9a,
+	0x26, 0x1e, 0x1d, 0x0c, 0x21, 0x3e, 0x27, 0x51, 0x2c, 0xb5, 0x74, 0x0a, 0x90, 0x00, 0x79, 0x57,
+	0x49, 0xe2, 0x95, 0x36, 0xdb, 0x52, 0xf5, 0xa5, 0xa2, 0x9c, 0x29, 0x30, 0xa3, 0x34, 0xf1, 0x38,
+	0x68, 0xe6, 0xd1, 0x88, 0x05, 0xa1, 0x60, 0x3a, 0x94, 0xc2, 0xb8, 0x4b, 0xcb, 0x66, 0xb6, 0x95,
+	0xbd, 0xa8, 0x79, 0x58, 0xa9, 0x1c, 0x48, 0x19, 0xf4, 0x80, 0xb2, 0x28, 0xa4, 0x4c, 0x08, 0xa9,
+	0x33, 0x9f, 0x55, 0xab, 0x27, 0xb8, 0x7c, 0x98, 0x46, 0xfb, 0x69, 0x33, 0x74, 0x76, 0x3a, 0x9d,
+	0x18, 0x94, 0x02, 0xd5, 0x84, 0xc1, 0x10, 0x94, 0x76, 0xf6, 0x30, 0x9e, 0x96, 0x15, 0x51, 0x05,
+	0xd5, 0xfe, 0x35, 0xd6, 0x89, 0x2d, 0x48, 0xc9, 0x88, 0xf9, 0x08, 0x4b, 0x46, 0x0e, 0x58, 0x00,
+	0xd6, 0xdb, 0x9c, 0x71, 0x56, 0x6f, 0x11, 0x5e, 0xf9, 0xa4, 0x48, 0x45, 0x52, 0x28, 0x70, 0x76,
+	0xf1, 0x7f, 0x6e, 0xb4, 0x16, 0x7b, 0x13, 0x8b, 0xa8, 0xf2, 0xab, 0xf6, 0xd7, 0x2f, 0x3e, 0xdc,
+	0xd5, 0x97, 0x6c, 0xa7, 0x35, 0x1e, 0xe9, 0x38, 0x14, 0x41, 0xb3, 0xc0, 0xe7, 0xe2, 0x9c, 0xfd,
+	0x0f, 0xc0, 0xf9, 0x0c, 0x78, 0xe3, 0x5b, 0x60, 0xc3, 0x30, 0x4b, 0xdc, 0xb8, 0x41, 0xf8, 0x77,
+	0x46, 0xec, 0x5c, 0x23, 0x5c, 0x98, 0xc7, 0x76, 0x08, 0x99, 0xff, 0x61, 0xe4, 0xab, 0x45, 0x96,
+	0xe8, 0x8f, 0xe7, 0x0d, 0x4b, 0x75, 0xeb, 0xe2, 0xf1, 0xe5, 0x2a, 0xbf, 0xe6, 0xac, 0xd2, 0x85,
+	0xbb, 0x59, 0xd8, 0x93, 0xef, 0xdf, 0x8f, 0x5d, 0x34, 0x1a, 0xbb, 0xe8, 0x79, 0xec, 0xa2, 0xcb,
+	0x89, 0x9b, 0x1b, 0x4d, 0xdc, 0xdc, 0xd3, 0xc4, 0xcd, 0x1d, 0xd7, 0x82, 0x50, 0x9f, 0x0e, 0x39,
+	0x69, 0xcb, 0x7e, 0x1a, 0x54, 0x17, 0xa0, 0xcf, 0x64, 0xdc, 0xcd, 0x42, 0x59, 0x14, 0x4d, 0x83,
+	0xf9, 0x9f, 0xec, 0x22, 0xb6, 0x5f, 0x07, 0x00, 0x35, 0x36, 0xae, 0x01, 0xa8, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlockedAddresses returns a page of the blocked addresses in store order.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/eve.blocklist.v1.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlockedAddresses returns a page of the blocked addresses in store order.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.blocklist.v1.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eve.blocklist.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eve/blocklist/v1/query.proto",
+}
+
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
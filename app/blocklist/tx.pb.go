@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/blocklist/v1/tx.proto
+
+package blocklist
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateBlocklist is the Msg/UpdateBlocklist request type.
+type MsgUpdateBlocklist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// add lists the addresses to block.
+	Add []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	// remove lists the addresses to unblock.
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateBlocklist) Reset()         { *m = MsgUpdateBlocklist{} }
+func (m *MsgUpdateBlocklist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlocklist) ProtoMessage()    {}
+func (*MsgUpdateBlocklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c71f5f0220f41b5, []int{0}
+}
+func (m *MsgUpdateBlocklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlocklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlocklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlocklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlocklist.Merge(m, src)
+}
+func (m *MsgUpdateBlocklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlocklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlocklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlocklist proto.InternalMessageInfo
+
+func (m *MsgUpdateBlocklist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateBlocklist) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *MsgUpdateBlocklist) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+// MsgUpdateBlocklistResponse is the Msg/UpdateBlocklist response type.
+type MsgUpdateBlocklistResponse struct {
+}
+
+func (m *MsgUpdateBlocklistResponse) Reset()         { *m = MsgUpdateBlocklistResponse{} }
+func (m *MsgUpdateBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlocklistResponse) ProtoMessage()    {}
+func (*MsgUpdateBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c71f5f0220f41b5, []int{1}
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlocklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlocklistResponse.Merge(m, src)
+}
+func (m *MsgUpdateBlocklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlocklistResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateBlocklist)(nil), "eve.blocklist.v1.MsgUpdateBlocklist")
+	proto.RegisterType((*MsgUpdateBlocklistResponse)(nil), "eve.blocklist.v1.MsgUpdateBlocklistResponse")
+}
+
+func init() { proto.RegisterFile("eve/blocklist/v1/tx.proto", fileDescriptor_5c71f5f0220f41b5) }
+
+var fileDescriptor_5c71f5f0220f41b5 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4e, 0x32, 0x41,
+	0x14, 0x85, 0x99, 0x7f, 0xf3, 0x93, 0x30, 0x85, 0x9a, 0x8d, 0x89, 0xcb, 0xc6, 0x4c, 0x08, 0xb1,
+	0x20, 0x44, 0x66, 0x44, 0x13, 0x0b, 0x3b, 0xb7, 0xa7, 0xc1, 0xd8, 0xd8, 0x98, 0x85, 0xbd, 0x19,
+	0x36, 0xb0, 0xcc, 0x66, 0xee, 0x30, 0x6a, 0x67, 0x7c, 0x02, 0x1f, 0x85, 0xc2, 0xca, 0x27, 0xb0,
+	0x24, 0x56, 0x96, 0x06, 0x0a, 0x5e, 0xc3, 0xc0, 0x82, 0x9b, 0x40, 0xa2, 0x76, 0x73, 0x73, 0xbe,
+	0x7b, 0xce, 0xcc, 0x1c, 0x5a, 0x06, 0x0b, 0xa2, 0x33, 0x50, 0xdd, 0xfe, 0x20, 0x46, 0x23, 0x6c,
+	0x53, 0x98, 0x7b, 0x9e, 0x6a, 0x65, 0x94, 0xbb, 0x07, 0x16, 0xf8, 0xb7, 0xc4, 0x6d, 0xd3, 0x3f,
+	0xe8, 0x2a, 0x4c, 0x14, 0x8a, 0x04, 0xe5, 0x82, 0x4c, 0x50, 0x66, 0xa8, 0x5f, 0xce, 0x84, 0xdb,
+	0xe5, 0x24, 0xb2, 0x21, 0x93, 0xaa, 0xaf, 0x84, 0xba, 0x2d, 0x94, 0xd7, 0x69, 0x14, 0x1a, 0x08,
+	0xd6, 0x6e, 0xee, 0x39, 0x2d, 0x85, 0x23, 0xd3, 0x53, 0x3a, 0x36, 0x0f, 0x1e, 0xa9, 0x90, 0x5a,
+	0x29, 0xf0, 0xde, 0x5f, 0x1a, 0xfb, 0xab, 0xdd, 0xcb, 0x28, 0xd2, 0x80, 0x78, 0x65, 0x74, 0x3c,
+	0x94, 0xed, 0x1c, 0x75, 0xeb, 0xd4, 0x09, 0xa3, 0xc8, 0xfb, 0x57, 0x71, 0x7e, 0xdc, 0x58, 0x40,
+	0xee, 0x09, 0x2d, 0x6a, 0x48, 0x94, 0x05, 0xcf, 0xf9, 0x05, 0x5f, 0x71, 0x17, 0x3b, 0x4f, 0xf3,
+	0x71, 0x3d, 0x4f, 0xab, 0x1e, 0x52, 0x7f, 0xfb, 0xee, 0x6d, 0xc0, 0x54, 0x0d, 0x11, 0x4e, 0x91,
+	0x3a, 0x2d, 0x94, 0x2e, 0xd0, 0xdd, 0xcd, 0xd7, 0x1d, 0xf1, 0xcd, 0xbf, 0xe3, 0xdb, 0x3e, 0xfe,
+	0xf1, 0x5f, 0xa8, 0x75, 0x9a, 0xff, 0xff, 0x71, 0x3e, 0xae, 0x93, 0x20, 0x78, 0x9b, 0x32, 0x32,
+	0x99, 0x32, 0xf2, 0x39, 0x65, 0xe4, 0x79, 0xc6, 0x0a, 0x93, 0x19, 0x2b, 0x7c, 0xcc, 0x58, 0xe1,
+	0xa6, 0x26, 0x63, 0xd3, 0x1b, 0x75, 0x78, 0x57, 0x25, 0x02, 0x2c, 0x34, 0x86, 0x60, 0xee, 0x94,
+	0xee, 0x2f, 0xce, 0x22, 0x4c, 0xd3, 0xbc, 0xe5, 0x4e, 0x71, 0x59, 0xcd, 0xd9, 0xd7, 0x00, 0x25,
+	0xe2, 0x21, 0x35, 0xfd, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateBlocklist adds addresses to and removes addresses from the
+	// blocklist. It can only be executed by the governance authority.
+	UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklist, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklist, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error) {
+	out := new(MsgUpdateBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/eve.blocklist.v1.Msg/UpdateBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateBlocklist adds addresses to and removes addresses from the
+	// blocklist. It can only be executed by the governance authority.
+	UpdateBlocklist(context.Context, *MsgUpdateBlocklist) (*MsgUpdateBlocklistResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateBlocklist(ctx context.Context, req *MsgUpdateBlocklist) (*MsgUpdateBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlocklist not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlocklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.blocklist.v1.Msg/UpdateBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlocklist(ctx, req.(*MsgUpdateBlocklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eve.blocklist.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateBlocklist",
+			Handler:    _Msg_UpdateBlocklist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eve/blocklist/v1/tx.proto",
+}
+
+func (m *MsgUpdateBlocklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlocklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlocklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlocklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlocklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlocklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateBlocklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBlocklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateBlocklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlocklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlocklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlocklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlocklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlocklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,148 @@
+package app
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/eve-network/eve/app/blocklist"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestAddressBlocklist(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addrs := AddTestAddrsIncremental(app, ctx, 2, sdkmath.NewInt(1_000_000))
+	sender, blocked := addrs[0], addrs[1]
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	updateBlocklist := func(msg *blocklist.MsgUpdateBlocklist) error {
+		handler := app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err := handler(ctx, msg)
+		return err
+	}
+
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, blocked, coins))
+
+	require.NoError(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: authority, Add: []string{blocked.String()}}))
+	require.Equal(t, []sdk.AccAddress{blocked}, app.BlocklistKeeper.GetBlockedAddresses(ctx))
+	require.ErrorIs(t, app.BankKeeper.SendCoins(ctx, sender, blocked, coins), sdkerrors.ErrUnauthorized)
+	// blocked addresses can still send their funds
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, blocked, sender, coins))
+	// module accounts can't pay them either, except for the gov deposit refunds
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, distrtypes.ModuleName, coins))
+	require.ErrorIs(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, blocked, coins), sdkerrors.ErrUnauthorized)
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, govtypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, govtypes.ModuleName, blocked, coins))
+
+	require.ErrorIs(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: sender.String(), Remove: []string{blocked.String()}}), govtypes.ErrInvalidSigner)
+	require.ErrorIs(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: authority, Add: []string{"invalid"}}), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: authority, Add: []string{sender.String(), sender.String()}}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: authority}), sdkerrors.ErrInvalidRequest)
+
+	require.NoError(t, updateBlocklist(&blocklist.MsgUpdateBlocklist{Authority: authority, Remove: []string{blocked.String()}}))
+	require.Empty(t, app.BlocklistKeeper.GetBlockedAddresses(ctx))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, blocked, coins))
+	require.Equal(t, sdkmath.NewInt(1_002_000), app.BankKeeper.GetBalance(ctx, blocked, bondDenom).Amount)
+}
+
+func TestAddressBlocklistIBCTransfer(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	transferModule, found := app.IBCKeeper.Router.GetRoute(ibctransfertypes.ModuleName)
+	require.True(t, found)
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-0", "uatom")).IBCDenom()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	_, _, relayer := testdata.KeyTestPubAddr()
+	recv := func(sequence uint64) ibcexported.Acknowledgement {
+		data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", receiver.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), sequence, ibctransfertypes.PortID, "channel-7", ibctransfertypes.PortID, "channel-0", clienttypes.NewHeight(1, 100), 0)
+		return transferModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// the vouchers minted for a blocked receiver are refused with an error
+	// acknowledgement
+	require.NoError(t, app.BlocklistKeeper.Block(ctx, receiver))
+	require.False(t, recv(1).Success())
+	require.True(t, app.BankKeeper.GetBalance(ctx, receiver, voucher).IsZero())
+
+	require.NoError(t, app.BlocklistKeeper.Unblock(ctx, receiver))
+	require.True(t, recv(2).Success())
+	require.Equal(t, sdkmath.NewInt(1000), app.BankKeeper.GetBalance(ctx, receiver, voucher).Amount)
+}
+
+func TestAddressBlocklistGenesisAndQuery(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addrs := AddTestAddrsIncremental(app, ctx, 3, sdkmath.NewInt(1_000_000))
+	for _, addr := range addrs {
+		require.NoError(t, app.BlocklistKeeper.Block(ctx, addr))
+	}
+
+	// the blocked addresses are exported and imported back
+	exported := app.BlocklistKeeper.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.BlockedAddresses, 3)
+	for _, addr := range addrs {
+		require.NoError(t, app.BlocklistKeeper.Unblock(ctx, addr))
+	}
+	require.NoError(t, app.BlocklistKeeper.InitGenesis(ctx, *exported))
+	require.Equal(t, exported, app.BlocklistKeeper.ExportGenesis(ctx))
+
+	require.Error(t, blocklist.GenesisState{BlockedAddresses: []string{"invalid"}}.Validate())
+	require.Error(t, blocklist.GenesisState{BlockedAddresses: []string{addrs[0].String(), addrs[0].String()}}.Validate())
+
+	// the query pages through the list
+	queryServer := blocklist.NewQueryServerImpl(app.BlocklistKeeper)
+	res, err := queryServer.BlockedAddresses(ctx, &blocklist.QueryBlockedAddressesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, exported.BlockedAddresses[:2], res.BlockedAddresses)
+	res, err = queryServer.BlockedAddresses(ctx, &blocklist.QueryBlockedAddressesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, exported.BlockedAddresses[2:], res.BlockedAddresses)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+func TestAddressBlocklistDepositRefund(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	depositor := AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))[0]
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000))
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the depositor proposes to block itself, the proposal never reaches the
+	// minimum deposit
+	msg := &blocklist.MsgUpdateBlocklist{Authority: authority, Add: []string{depositor.String()}}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "blocklist", "block the depositor", depositor, false)
+	require.NoError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, depositor, deposit)
+	require.NoError(t, err)
+	require.NoError(t, app.BlocklistKeeper.Block(ctx, depositor))
+
+	// the expired proposal refunds its deposit to the blocked depositor
+	// without failing the gov EndBlocker
+	ctx = ctx.WithBlockTime(proposal.DepositEndTime.Add(1))
+	require.NoError(t, gov.EndBlocker(ctx, &app.GovKeeper))
+	_, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.Error(t, err)
+	require.Equal(t, sdkmath.NewInt(1_000_000), app.BankKeeper.GetBalance(ctx, depositor, bondDenom).Amount)
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// moduleMsgServices maps the modules of the app to the full names of their
+// Msg services. The module names don't match the proto packages, ibc covers
+// the ibc core services but none of the IBC apps and feeibc is
+// ibc.applications.fee, so the services are listed rather than derived.
+var moduleMsgServices = map[string][]string{
+	"auth":               {"cosmos.auth.v1beta1.Msg"},
+	"authz":              {"cosmos.authz.v1beta1.Msg"},
+	"bank":               {"cosmos.bank.v1beta1.Msg"},
+	"consensus":          {"cosmos.consensus.v1.Msg"},
+	"crisis":             {"cosmos.crisis.v1beta1.Msg"},
+	"distribution":       {"cosmos.distribution.v1beta1.Msg"},
+	"evidence":           {"cosmos.evidence.v1beta1.Msg"},
+	"feegrant":           {"cosmos.feegrant.v1beta1.Msg"},
+	"group":              {"cosmos.group.v1.Msg"},
+	"mint":               {"cosmos.mint.v1beta1.Msg"},
+	"nft":                {"cosmos.nft.v1beta1.Msg"},
+	"slashing":           {"cosmos.slashing.v1beta1.Msg"},
+	"staking":            {"cosmos.staking.v1beta1.Msg"},
+	"upgrade":            {"cosmos.upgrade.v1beta1.Msg"},
+	"vesting":            {"cosmos.vesting.v1beta1.Msg"},
+	"wasm":               {"cosmwasm.wasm.v1.Msg"},
+	"blocklist":          {"eve.blocklist.v1.Msg"},
+	"feebypass":          {"eve.feebypass.v1.Msg"},
+	"mingasprices":       {"eve.mingasprices.v1.Msg"},
+	"wasmallowlist":      {"eve.wasmallowlist.v1.Msg"},
+	"feeabs":             {"feeabstraction.feeabs.v1beta1.Msg"},
+	"feemarket":          {"feemarket.feemarket.v1.Msg"},
+	"feeibc":             {"ibc.applications.fee.v1.Msg"},
+	"interchainaccounts": {"ibc.applications.interchain_accounts.controller.v1.Msg", "ibc.applications.interchain_accounts.host.v1.Msg"},
+	"transfer":           {"ibc.applications.transfer.v1.Msg"},
+	"ibc":                {"ibc.core.client.v1.Msg", "ibc.core.connection.v1.Msg", "ibc.core.channel.v1.Msg"},
+	"08-wasm":            {"ibc.lightclients.wasm.v1.Msg"},
+	"tokenfactory":       {"tokenfactory.v1beta1.Msg"},
+}
+
+// ModuleMsgTypeURLs returns the sorted type URLs of the routable messages of
+// the Msg services of a module, see moduleMsgServices.
+func (app *EveApp) ModuleMsgTypeURLs(moduleName string) ([]string, error) {
+	var typeURLs []string
+	for _, service := range moduleMsgServices[moduleName] {
+		desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+		if err != nil {
+			return nil, fmt.Errorf("msg service %s of module %s: %w", service, moduleName, err)
+		}
+		serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s of module %s is not a service", service, moduleName)
+		}
+
+		methods := serviceDesc.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			typeURL := "/" + string(methods.Get(i).Input().FullName())
+			if app.MsgServiceRouter().HandlerByTypeURL(typeURL) != nil {
+				typeURLs = append(typeURLs, typeURL)
+			}
+		}
+	}
+	sort.Strings(typeURLs)
+	return typeURLs, nil
+}
+
+// NewModulePauseMsg returns a MsgTripCircuitBreaker disabling all the messages
+// of a module at once. The authority is usually the gov module account, the
+// message then being submitted through a governance proposal.
+func (app *EveApp) NewModulePauseMsg(authority, moduleName string) (*circuittypes.MsgTripCircuitBreaker, error) {
+	// pausing the circuit or the gov module would leave no way to reset the
+	// breakers
+	if moduleName == circuittypes.ModuleName || moduleName == govtypes.ModuleName {
+		return nil, fmt.Errorf("module %s can't be paused", moduleName)
+	}
+
+	typeURLs, err := app.ModuleMsgTypeURLs(moduleName)
+	if err != nil {
+		return nil, err
+	}
+	if len(typeURLs) == 0 {
+		return nil, fmt.Errorf("no messages found for module %s", moduleName)
+	}
+	return &circuittypes.MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: typeURLs,
+	}, nil
+}
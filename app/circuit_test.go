@@ -0,0 +1,83 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	tokenfactorytypes "github.com/osmosis-labs/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestModuleMsgTypeURLs(t *testing.T) {
+	app := Setup(t)
+
+	for moduleName := range moduleMsgServices {
+		require.Contains(t, app.ModuleManager.Modules, moduleName)
+		typeURLs, err := app.ModuleMsgTypeURLs(moduleName)
+		require.NoError(t, err)
+		require.NotEmpty(t, typeURLs, moduleName)
+	}
+
+	// ibc only covers the ibc core messages
+	typeURLs, err := app.ModuleMsgTypeURLs("ibc")
+	require.NoError(t, err)
+	require.Contains(t, typeURLs, sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}))
+	require.NotContains(t, typeURLs, sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}))
+	require.NotContains(t, typeURLs, sdk.MsgTypeURL(&ibcfeetypes.MsgPayPacketFee{}))
+
+	typeURLs, err = app.ModuleMsgTypeURLs(ibcfeetypes.ModuleName)
+	require.NoError(t, err)
+	require.Contains(t, typeURLs, sdk.MsgTypeURL(&ibcfeetypes.MsgPayPacketFee{}))
+}
+
+func TestNewModulePauseMsg(t *testing.T) {
+	app := Setup(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	msg, err := app.NewModulePauseMsg(authority, tokenfactorytypes.ModuleName)
+	require.NoError(t, err)
+	require.Contains(t, msg.MsgTypeUrls, sdk.MsgTypeURL(&tokenfactorytypes.MsgCreateDenom{}))
+	require.Contains(t, msg.MsgTypeUrls, sdk.MsgTypeURL(&tokenfactorytypes.MsgMint{}))
+	require.NotContains(t, msg.MsgTypeUrls, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	_, err = circuitkeeper.NewMsgServerImpl(app.CircuitKeeper).TripCircuitBreaker(ctx, msg)
+	require.NoError(t, err)
+
+	// the paused messages are rejected by the circuit breaker when delivered,
+	// the others go past it, to fail on the missing fee
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	deliver := func(msg sdk.Msg) *abci.ExecTxResult {
+		res, err := SignAndDeliverWithoutCommit(t, app.TxConfig(), app.BaseApp, []sdk.Msg{msg}, sdk.Coins{}, app.ChainID(), []uint64{0}, []uint64{0}, ctx.BlockTime(), priv)
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+		return res.TxResults[0]
+	}
+	res := deliver(tokenfactorytypes.NewMsgCreateDenom(sender.String(), "paused"))
+	require.NotZero(t, res.Code)
+	require.Contains(t, res.Log, "tx type not allowed")
+	res = deliver(banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))))
+	require.NotContains(t, res.Log, "tx type not allowed")
+
+	_, err = app.NewModulePauseMsg(authority, circuittypes.ModuleName)
+	require.Error(t, err)
+	_, err = app.NewModulePauseMsg(authority, govtypes.ModuleName)
+	require.Error(t, err)
+	_, err = app.NewModulePauseMsg(authority, "unknown")
+	require.Error(t, err)
+}
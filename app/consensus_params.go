@@ -0,0 +1,48 @@
+package app
+
+import (
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeConsensusParamsMigration is emitted with the first block
+	// finalized by the node when it migrated the consensus params.
+	EventTypeConsensusParamsMigration = "consensus_params_migration"
+	AttributeKeyMigrated              = "migrated"
+)
+
+// runConsensusParamsMigration migrates the consensus params, logging and
+// recording the outcome. It returns the event to emit with the block, which
+// is only set when the params were migrated so that all the nodes emit it.
+func (app *EveApp) runConsensusParamsMigration(ctx sdk.Context) []abci.Event {
+	migrated, err := app.migrateConsensusParams(ctx)
+	if err != nil {
+		app.Logger().Error("consensus params migration failed", "err", err)
+		panic(err)
+	}
+	app.Logger().Info("consensus params migration checked", "migrated", migrated)
+	app.consensusParamsMigration.Store(&ConsensusParamsMigration{Checked: true, Migrated: migrated})
+
+	if !migrated {
+		return nil
+	}
+	return []abci.Event{{
+		Type: EventTypeConsensusParamsMigration,
+		Attributes: []abci.EventAttribute{
+			{Key: AttributeKeyMigrated, Value: strconv.FormatBool(migrated)},
+		},
+	}}
+}
+
+// ConsensusParamsMigration returns the outcome of the consensus params
+// migration run by the node.
+func (app *EveApp) ConsensusParamsMigration() ConsensusParamsMigration {
+	if migration := app.consensusParamsMigration.Load(); migration != nil {
+		return *migration
+	}
+	return ConsensusParamsMigration{}
+}
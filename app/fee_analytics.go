@@ -0,0 +1,42 @@
+package app
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypeFeeAnalytics = "fee_analytics"
+
+	AttributeKeyFee       = "fee"
+	AttributeKeyGasUsed   = "gas_used"
+	AttributeKeyGasWanted = "gas_wanted"
+	AttributeKeyPayer     = "payer"
+)
+
+// FeeAnalyticsDecorator emits an event with the fee, gas and payer of every
+// successful transaction, for fee revenue dashboards. It only observes, and is
+// a no-op outside of block execution so simulations and CheckTx are not counted.
+type FeeAnalyticsDecorator struct{}
+
+func NewFeeAnalyticsDecorator() FeeAnalyticsDecorator {
+	return FeeAnalyticsDecorator{}
+}
+
+func (FeeAnalyticsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !success || simulate || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return next(ctx, tx, simulate, success)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeFeeAnalytics,
+		sdk.NewAttribute(AttributeKeyFee, feeTx.GetFee().String()),
+		sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(ctx.GasMeter().GasConsumed(), 10)),
+		sdk.NewAttribute(AttributeKeyGasWanted, strconv.FormatUint(feeTx.GetGas(), 10)),
+		sdk.NewAttribute(AttributeKeyPayer, sdk.AccAddress(feeTx.FeePayer()).String()),
+	))
+
+	return next(ctx, tx, simulate, success)
+}
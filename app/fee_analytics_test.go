@@ -0,0 +1,67 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeAnalyticsDecorator(t *testing.T) {
+	app := Setup(t)
+	_, _, payer := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+
+	txBuilder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(100_000)
+	tx := txBuilder.GetTx()
+
+	testCases := []struct {
+		name      string
+		execMode  sdk.ExecMode
+		simulate  bool
+		success   bool
+		expEvents bool
+	}{
+		{"successful tx in block, should emit", sdk.ExecModeFinalize, false, true, true},
+		{"failed tx in block, should not emit", sdk.ExecModeFinalize, false, false, false},
+		{"check tx, should not emit", sdk.ExecModeCheck, false, true, false},
+		{"simulation, should not emit", sdk.ExecModeSimulate, true, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := app.BaseApp.NewContext(false).
+				WithExecMode(tc.execMode).
+				WithEventManager(sdk.NewEventManager()).
+				WithGasMeter(storetypes.NewGasMeter(100_000))
+			ctx.GasMeter().ConsumeGas(42_000, "test")
+
+			terminator := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+			ctx, err := NewFeeAnalyticsDecorator().PostHandle(ctx, tx, tc.simulate, tc.success, terminator)
+			require.NoError(t, err)
+
+			events := ctx.EventManager().Events()
+			if !tc.expEvents {
+				require.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			attrs := make(map[string]string)
+			for _, attr := range events[0].Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			require.Equal(t, EventTypeFeeAnalytics, events[0].Type)
+			require.Equal(t, fee.String(), attrs[AttributeKeyFee])
+			require.Equal(t, "42000", attrs[AttributeKeyGasUsed])
+			require.Equal(t, "100000", attrs[AttributeKeyGasWanted])
+			require.Equal(t, payer.String(), attrs[AttributeKeyPayer])
+		})
+	}
+}
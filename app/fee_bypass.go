@@ -0,0 +1,31 @@
+package app
+
+import (
+	"github.com/eve-network/eve/app/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeBypassPostDecorator skips the wrapped fee deduction for the fee bypass
+// transactions let through by the ante handler, which have no fee to pay out.
+// Their gas isn't counted by the fee market, which is why the fee bypass
+// params cap it.
+type FeeBypassPostDecorator struct {
+	feeBypassKeeper ante.FeeBypassKeeper
+	feeDecorator    sdk.PostDecorator
+}
+
+func NewFeeBypassPostDecorator(feeBypassKeeper ante.FeeBypassKeeper, feeDecorator sdk.PostDecorator) FeeBypassPostDecorator {
+	return FeeBypassPostDecorator{feeBypassKeeper: feeBypassKeeper, feeDecorator: feeDecorator}
+}
+
+func (d FeeBypassPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	bypass, err := ante.IsFeeBypassTx(ctx, d.feeBypassKeeper, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if bypass {
+		return next(ctx, tx, simulate, success)
+	}
+	return d.feeDecorator.PostHandle(ctx, tx, simulate, success, next)
+}
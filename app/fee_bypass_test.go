@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/eve-network/eve/app/feebypass"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestFeeBypassParams(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the genesis lets the IBC relayer messages through
+	params, err := app.FeeBypassKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, feebypass.DefaultParams(), params)
+
+	updateParams := func(msg *feebypass.MsgUpdateParams) error {
+		handler := app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err := handler(ctx, msg)
+		return err
+	}
+	updated := feebypass.Params{MsgTypes: feebypass.DefaultMsgTypes[:1], MaxGas: 500_000}
+	require.ErrorIs(t, updateParams(&feebypass.MsgUpdateParams{Authority: authority, Params: feebypass.Params{MsgTypes: []string{"invalid"}}}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, updateParams(&feebypass.MsgUpdateParams{Authority: authtypes.NewModuleAddress("other").String(), Params: updated}), govtypes.ErrInvalidSigner)
+	require.NoError(t, updateParams(&feebypass.MsgUpdateParams{Authority: authority, Params: updated}))
+
+	res, err := feebypass.NewQueryServerImpl(app.FeeBypassKeeper).Params(ctx, &feebypass.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, updated, res.Params)
+
+	// the params are exported
+	exported, err := app.FeeBypassKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, updated, exported.Params)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketInfo returns the fee market params and state, along with the
+// minimum gas prices in every accepted fee denom.
+func (app *EveApp) FeeMarketInfo(ctx sdk.Context) (FeeMarketInfo, error) {
+	params, err := app.FeeMarketKeeper.GetParams(ctx)
+	if err != nil {
+		return FeeMarketInfo{}, err
+	}
+	state, err := app.FeeMarketKeeper.GetState(ctx)
+	if err != nil {
+		return FeeMarketInfo{}, err
+	}
+	minGasPrices, err := app.FeeMarketKeeper.GetMinGasPrices(ctx)
+	if err != nil {
+		return FeeMarketInfo{}, err
+	}
+
+	var blockUtilization uint64
+	if state.Index < uint64(len(state.Window)) {
+		blockUtilization = state.Window[state.Index]
+	}
+	return FeeMarketInfo{
+		Enabled:                params.Enabled,
+		FeeDenom:               params.FeeDenom,
+		BaseGasPrice:           state.BaseGasPrice,
+		MinBaseGasPrice:        params.MinBaseGasPrice,
+		LearningRate:           state.LearningRate,
+		BlockUtilization:       blockUtilization,
+		TargetBlockUtilization: params.TargetBlockUtilization(),
+		MaxBlockUtilization:    params.MaxBlockUtilization,
+		MinGasPrices:           minGasPrices,
+	}, nil
+}
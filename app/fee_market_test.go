@@ -0,0 +1,103 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeMarketInfo(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	// start from a raised base gas price so that empty blocks lower it
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	params, err := app.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	state, err := app.FeeMarketKeeper.GetState(ctx)
+	require.NoError(t, err)
+	raised := params.MinBaseGasPrice.MulInt64(10)
+	state.BaseGasPrice = raised
+	require.NoError(t, app.FeeMarketKeeper.SetState(ctx, state))
+
+	for i := 0; i < 3; i++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	ctx, err = app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	info, err := app.FeeMarketInfo(ctx)
+	require.NoError(t, err)
+	state, err = app.FeeMarketKeeper.GetState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, state.BaseGasPrice, info.BaseGasPrice)
+	require.Equal(t, state.LearningRate, info.LearningRate)
+	require.True(t, info.BaseGasPrice.LT(raised))
+	require.Equal(t, params.MaxBlockUtilization/2, info.TargetBlockUtilization)
+
+	// the ante handler enforces the queried price
+	enforced, err := app.FeeMarketKeeper.GetMinGasPrice(ctx, params.FeeDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(enforced), info.MinGasPrices)
+	require.Equal(t, enforced.Amount, info.BaseGasPrice)
+
+	var res QueryFeeMarketResponse
+	queryApp(t, app, "FeeMarket", &QueryFeeMarketRequest{}, &res)
+	require.Equal(t, app.AppCodec().MustMarshal(&info), app.AppCodec().MustMarshal(&res.FeeMarket))
+}
+
+func TestFeeMarketGenesisRoundTrip(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	params, err := app.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MinBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+	state, err := app.FeeMarketKeeper.GetState(ctx)
+	require.NoError(t, err)
+	state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(7)
+	state.LearningRate = params.MaxLearningRate
+	state.Window[0] = params.MaxBlockUtilization / 3
+	require.NoError(t, app.FeeMarketKeeper.SetState(ctx, state))
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	ctx, err = app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	exportedState, err := app.FeeMarketKeeper.GetState(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, feemarkettypes.DefaultGenesisState().State.BaseGasPrice, exportedState.BaseGasPrice)
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+
+	imported := SetupWithEmptyStore(t)
+	_, err = imported.InitChain(&abci.RequestInitChain{
+		ChainId:         "testing",
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+	})
+	require.NoError(t, err)
+	importedCtx := imported.BaseApp.NewContext(false)
+
+	importedParams, err := imported.FeeMarketKeeper.GetParams(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, params, importedParams)
+	importedState, err := imported.FeeMarketKeeper.GetState(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, exportedState, importedState)
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ibcTestingApp adapts EveApp to ibctesting.TestingApp.
+type ibcTestingApp struct {
+	*EveApp
+}
+
+func (app ibcTestingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// setupIBCTesting makes the ibctesting coordinator run EveApp chains. The fee
+// market is disabled in genesis as the ibctesting relayer pays no fees.
+func setupIBCTesting(t *testing.T) {
+	t.Helper()
+	defaultInit := ibctesting.DefaultTestingAppInit
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = defaultInit })
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app, genesis := setup(t, "", true, 0)
+		var feemarketGenesis feemarkettypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesis[feemarkettypes.ModuleName], &feemarketGenesis)
+		feemarketGenesis.Params.Enabled = false
+		genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(&feemarketGenesis)
+		return ibcTestingApp{app}, genesis
+	}
+}
+
+func TestFeeAbsIBCFeePayment(t *testing.T) {
+	setupIBCTesting(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	eve := coordinator.GetChain(ibctesting.GetChainID(1))
+	counterparty := coordinator.GetChain(ibctesting.GetChainID(2))
+	eveApp := eve.App.(ibcTestingApp).EveApp
+
+	path := ibctesting.NewTransferPath(eve, counterparty)
+	coordinator.Setup(path)
+
+	// send the counterparty's bond denom to eve
+	sender := eve.SenderAccount.GetAddress()
+	amount := sdkmath.NewInt(100_000_000_000)
+	msg := transfertypes.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount), counterparty.SenderAccount.GetAddress().String(), sender.String(), eve.GetTimeoutHeight(), 0, "")
+	res, err := counterparty.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	require.Equal(t, amount, eveApp.BankKeeper.GetBalance(eve.GetContext(), sender, voucher).Amount)
+
+	// enable the fee market now that the channel is open
+	ctx := eve.GetContext()
+	params, err := eveApp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.Enabled = true
+	require.NoError(t, eveApp.FeeMarketKeeper.SetParams(ctx, params))
+
+	deliver := func(fee sdk.Coin) (uint32, sdk.Coin) {
+		t.Helper()
+		ctx := eve.GetContext()
+		account := eveApp.AccountKeeper.GetAccount(ctx, sender)
+		send := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin(voucher, 1)))
+		res, err := SignAndDeliverWithoutCommit(t, eve.TxConfig, eveApp.BaseApp, []sdk.Msg{send}, sdk.NewCoins(fee), eve.ChainID, []uint64{account.GetAccountNumber()}, []uint64{account.GetSequence()}, eve.CurrentHeader.GetTime(), eve.SenderPrivKey)
+		require.NoError(t, err)
+		_, err = eveApp.Commit()
+		require.NoError(t, err)
+		return res.TxResults[0].Code, eveApp.BankKeeper.GetBalance(eve.GetContext(), sender, voucher)
+	}
+	before := eveApp.BankKeeper.GetBalance(ctx, sender, voucher)
+
+	// the voucher is not a host zone denom yet
+	code, balance := deliver(sdk.NewCoin(voucher, sdkmath.NewInt(1_000_000_000)))
+	require.NotZero(t, code)
+	require.Equal(t, before, balance)
+
+	ctx = eve.GetContext()
+	require.NoError(t, eveApp.FeeabsKeeper.SetHostZoneConfig(ctx, feeabstypes.HostChainFeeAbsConfig{
+		IbcDenom:                voucher,
+		OsmosisPoolTokenDenomIn: "ibc/osmo",
+		PoolId:                  1,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+	}))
+	eveApp.FeeabsKeeper.SetTwapRate(ctx, voucher, sdkmath.LegacyNewDec(2))
+
+	// the base gas price is converted to the voucher at the twap rate
+	nativePrice, err := eveApp.FeeMarketKeeper.GetMinGasPrice(ctx, params.FeeDenom)
+	require.NoError(t, err)
+	voucherPrice, err := eveApp.FeeMarketKeeper.GetMinGasPrice(ctx, voucher)
+	require.NoError(t, err)
+	require.Equal(t, voucher, voucherPrice.Denom)
+	converted, err := eveApp.FeeMarketKeeper.ResolveToDenom(ctx, voucherPrice, params.FeeDenom)
+	require.NoError(t, err)
+	require.Equal(t, nativePrice.Amount, converted.Amount)
+
+	fee := sdk.NewCoin(voucher, voucherPrice.Amount.MulInt64(int64(simtestutil.DefaultGenTxGas)).Ceil().TruncateInt())
+	code, balance = deliver(fee)
+	require.Zero(t, code)
+	// the fee market check and the DeductFeeDecorator both charge the fee
+	require.Equal(t, before.Sub(fee).Sub(fee), balance)
+}
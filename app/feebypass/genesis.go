@@ -0,0 +1,26 @@
+package feebypass
+
+import (
+	"context"
+)
+
+// DefaultGenesisState returns the default fee bypass genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate validates the genesis parameters.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
+
+// InitGenesis sets the parameters of the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs GenesisState) error {
+	return k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis returns the parameters as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	return &GenesisState{Params: params}, err
+}
@@ -0,0 +1,32 @@
+package feebypass
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns the fee bypass Query service implementation.
+func NewQueryServerImpl(keeper Keeper) QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Params returns the fee bypass parameters.
+func (k queryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryParamsResponse{Params: params}, nil
+}
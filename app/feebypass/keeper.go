@@ -0,0 +1,64 @@
+package feebypass
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// ModuleName is the name of the fee bypass module.
+	ModuleName = "feebypass"
+
+	// StoreKey is the key of the store holding the fee bypass parameters.
+	StoreKey = ModuleName
+)
+
+// ParamsKey is the store key of the fee bypass parameters.
+var ParamsKey = []byte{0x01}
+
+// Keeper manages the governance controlled fee bypass parameters, naming the
+// transactions the fee market lets through without fees.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService corestore.KVStoreService
+	authority    string
+}
+
+// NewKeeper returns the fee bypass keeper. Only authority can update the
+// parameters.
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, authority string) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the fee bypass parameters. They are empty, bypassing
+// nothing, until set.
+func (k Keeper) GetParams(ctx context.Context) (Params, error) {
+	var params Params
+	bz, err := k.storeService.OpenKVStore(ctx).Get(ParamsKey)
+	if err != nil || bz == nil {
+		return params, err
+	}
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+// SetParams sets the fee bypass parameters.
+func (k Keeper) SetParams(ctx context.Context, params Params) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(ParamsKey, bz)
+}
@@ -0,0 +1,69 @@
+package feebypass
+
+import (
+	"fmt"
+	"slices"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxGas is the default highest gas limit of a transaction sent without
+// fees.
+const DefaultMaxGas = 1_000_000
+
+// DefaultMsgTypes are the IBC relayer messages that can be sent without fees
+// by default.
+var DefaultMsgTypes = []string{
+	sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}),
+	sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{}),
+	sdk.MsgTypeURL(&channeltypes.MsgTimeout{}),
+	sdk.MsgTypeURL(&channeltypes.MsgTimeoutOnClose{}),
+	sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}),
+}
+
+// DefaultParams returns the default fee bypass parameters, letting the IBC
+// relayer messages through without fees.
+func DefaultParams() Params {
+	return Params{
+		MsgTypes: slices.Clone(DefaultMsgTypes),
+		MaxGas:   DefaultMaxGas,
+	}
+}
+
+// Validate checks that the message types are type URLs and unique.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.MsgTypes))
+	for _, msgType := range p.MsgTypes {
+		if len(msgType) < 2 || msgType[0] != '/' {
+			return fmt.Errorf("invalid msg type URL %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate msg type URL %s", msgType)
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
+
+// IsBypassTx returns true for the transactions carrying no fee, a gas limit up
+// to MaxGas and only messages of the bypass types.
+func (p Params) IsBypassTx(tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !feeTx.GetFee().IsZero() || feeTx.GetGas() > p.MaxGas {
+		return false
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !slices.Contains(p.MsgTypes, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	feeabsmodule "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs"
+	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// hostZoneProposalHandler wraps feeabs' host zone proposal handler, rejecting
+// the host zones added or set for an IBC denom that didn't come through a
+// transfer channel of Eve which is open. The twap rate of a host zone is only
+// meaningful for the tokens of that channel. The keepers are read when a
+// proposal is handled as the gov router is set up before they are created.
+func (app *EveApp) hostZoneProposalHandler() govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		var config *feeabstypes.HostChainFeeAbsConfig
+		switch c := content.(type) {
+		case *feeabstypes.AddHostZoneProposal:
+			config = c.HostChainConfig
+		case *feeabstypes.SetHostZoneProposal:
+			config = c.HostChainConfig
+		}
+		if config != nil {
+			if err := app.validateHostZoneChannel(ctx, config.IbcDenom); err != nil {
+				return err
+			}
+		}
+		return feeabsmodule.NewHostZoneProposal(app.FeeabsKeeper)(ctx, content)
+	}
+}
+
+// feeabsAppModule is the feeabs module with its msg server wrapped by
+// hostZoneMsgServer, so that the host zones added or updated by gov v1
+// proposals go through the same channel check as the legacy proposals.
+type feeabsAppModule struct {
+	feeabsmodule.AppModule
+	app *EveApp
+}
+
+func newFeeabsAppModule(app *EveApp, module feeabsmodule.AppModule) feeabsAppModule {
+	return feeabsAppModule{AppModule: module, app: app}
+}
+
+func (am feeabsAppModule) RegisterServices(cfg module.Configurator) {
+	feeabstypes.RegisterMsgServer(cfg.MsgServer(), hostZoneMsgServer{MsgServer: feeabskeeper.NewMsgServerImpl(am.app.FeeabsKeeper), app: am.app})
+	feeabstypes.RegisterQueryServer(cfg.QueryServer(), feeabskeeper.NewQuerier(am.app.FeeabsKeeper))
+}
+
+// hostZoneMsgServer rejects MsgAddHostZone and MsgUpdateHostZone for an IBC
+// denom that didn't come through an open transfer channel of Eve, before
+// handing them to the feeabs msg server.
+type hostZoneMsgServer struct {
+	feeabstypes.MsgServer
+	app *EveApp
+}
+
+func (s hostZoneMsgServer) AddHostZone(ctx context.Context, msg *feeabstypes.MsgAddHostZone) (*feeabstypes.MsgAddHostZoneResponse, error) {
+	if err := s.app.validateHostZoneChannel(sdk.UnwrapSDKContext(ctx), msg.HostChainConfig.IbcDenom); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.AddHostZone(ctx, msg)
+}
+
+func (s hostZoneMsgServer) UpdateHostZone(ctx context.Context, msg *feeabstypes.MsgUpdateHostZone) (*feeabstypes.MsgUpdateHostZoneResponse, error) {
+	if err := s.app.validateHostZoneChannel(sdk.UnwrapSDKContext(ctx), msg.HostChainConfig.IbcDenom); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.UpdateHostZone(ctx, msg)
+}
+
+// validateHostZoneChannel checks that the IBC denom was received through a
+// channel which exists and is open.
+func (app *EveApp) validateHostZoneChannel(ctx sdk.Context, ibcDenom string) error {
+	path, err := app.TransferKeeper.DenomPathFromHash(ctx, ibcDenom)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host zone denom %s is not a known IBC denom: %s", ibcDenom, err)
+	}
+	// the first hop of the path is the port and channel on Eve's side
+	hops := strings.SplitN(ibctransfertypes.ParseDenomTrace(path).Path, "/", 3)
+	if len(hops) < 2 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host zone denom %s has no channel in its path %s", ibcDenom, path)
+	}
+	portID, channelID := hops[0], hops[1]
+
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "host zone denom %s: port %s, channel %s", ibcDenom, portID, channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "host zone denom %s: channel %s is %s, expected %s", ibcDenom, channelID, channel.State, channeltypes.OPEN)
+	}
+	return nil
+}
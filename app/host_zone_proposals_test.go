@@ -0,0 +1,147 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestHostZoneProposalChannelValidation(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	openVoucher, missingVoucher, initVoucher := receiveHostZoneVouchers(t, app, ctx)
+
+	handler := app.GovKeeper.LegacyRouter().GetRoute(feeabstypes.RouterKey)
+	addHostZone := func(ibcDenom string) error {
+		return handler(ctx, &feeabstypes.AddHostZoneProposal{
+			Title:       "add host zone",
+			Description: "add host zone",
+			HostChainConfig: &feeabstypes.HostChainFeeAbsConfig{
+				IbcDenom:                ibcDenom,
+				OsmosisPoolTokenDenomIn: "ibc/osmoatom",
+				PoolId:                  1,
+				Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+			},
+		})
+	}
+
+	testCases := []struct {
+		name     string
+		ibcDenom string
+		expErr   error
+	}{
+		{"denom of an open channel, should pass", openVoucher, nil},
+		{"denom of a missing channel, should fail", missingVoucher, channeltypes.ErrChannelNotFound},
+		{"denom of a channel not open, should fail", initVoucher, channeltypes.ErrInvalidChannelState},
+		{"unknown ibc denom, should fail", "ibc/0000000000000000000000000000000000000000000000000000000000000000", sdkerrors.ErrInvalidRequest},
+		{"native denom, should fail", "stake", sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := addHostZone(tc.ibcDenom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.False(t, app.FeeabsKeeper.HasHostZoneConfig(ctx, tc.ibcDenom))
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, app.FeeabsKeeper.HasHostZoneConfig(ctx, tc.ibcDenom))
+		})
+	}
+
+	// deleting a host zone needs no channel
+	require.NoError(t, handler(ctx, &feeabstypes.DeleteHostZoneProposal{Title: "delete host zone", Description: "delete host zone", IbcDenom: openVoucher}))
+	require.False(t, app.FeeabsKeeper.HasHostZoneConfig(ctx, openVoucher))
+}
+
+func TestHostZoneMsgChannelValidation(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+	openVoucher, missingVoucher, _ := receiveHostZoneVouchers(t, app, ctx)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the proposals are voted by the delegator of the genesis validator
+	delegations, err := app.StakingKeeper.GetAllDelegations(ctx)
+	require.NoError(t, err)
+	voter := sdk.MustAccAddressFromBech32(delegations[0].DelegatorAddress)
+	params, err := app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	runProposal := func(msg sdk.Msg) govv1.ProposalStatus {
+		t.Helper()
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "host zone", "host zone", voter, false)
+		require.NoError(t, err)
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, voter, params.MinDeposit)
+		require.NoError(t, err)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, voter, govv1.NewNonSplitVoteOption(govv1.OptionYes), ""))
+
+		proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+		require.NoError(t, err)
+		ctx = ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second))
+		require.NoError(t, gov.EndBlocker(ctx, &app.GovKeeper))
+		proposal, err = app.GovKeeper.Proposals.Get(ctx, proposal.Id)
+		require.NoError(t, err)
+		return proposal.Status
+	}
+	hostZone := func(ibcDenom string) feeabstypes.HostChainFeeAbsConfig {
+		return feeabstypes.HostChainFeeAbsConfig{
+			IbcDenom:                ibcDenom,
+			OsmosisPoolTokenDenomIn: "ibc/osmoatom",
+			PoolId:                  1,
+			Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+		}
+	}
+
+	// the host zone of a missing channel is neither added nor updated
+	require.Equal(t, govv1.StatusFailed, runProposal(&feeabstypes.MsgAddHostZone{Authority: authority, HostChainConfig: hostZone(missingVoucher)}))
+	require.False(t, app.FeeabsKeeper.HasHostZoneConfig(ctx, missingVoucher))
+	require.NoError(t, app.FeeabsKeeper.SetHostZoneConfig(ctx, hostZone(missingVoucher)))
+	updated := hostZone(missingVoucher)
+	updated.PoolId = 2
+	require.Equal(t, govv1.StatusFailed, runProposal(&feeabstypes.MsgUpdateHostZone{Authority: authority, HostChainConfig: updated}))
+	config, found := app.FeeabsKeeper.GetHostZoneConfig(ctx, missingVoucher)
+	require.True(t, found)
+	require.Equal(t, uint64(1), config.PoolId)
+
+	// the host zone of an open channel is
+	require.Equal(t, govv1.StatusPassed, runProposal(&feeabstypes.MsgAddHostZone{Authority: authority, HostChainConfig: hostZone(openVoucher)}))
+	require.True(t, app.FeeabsKeeper.HasHostZoneConfig(ctx, openVoucher))
+	updated = hostZone(openVoucher)
+	updated.PoolId = 2
+	require.Equal(t, govv1.StatusPassed, runProposal(&feeabstypes.MsgUpdateHostZone{Authority: authority, HostChainConfig: updated}))
+	config, found = app.FeeabsKeeper.GetHostZoneConfig(ctx, openVoucher)
+	require.True(t, found)
+	require.Equal(t, uint64(2), config.PoolId)
+}
+
+// receiveHostZoneVouchers receives vouchers through channel-0, which is open,
+// and channel-1 and channel-2, which don't exist and are being opened
+// respectively, returning their IBC denoms.
+func receiveHostZoneVouchers(t *testing.T, app *EveApp, ctx sdk.Context) (openVoucher, missingVoucher, initVoucher string) {
+	t.Helper()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	voucherOf := func(channelID string) string {
+		data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", receiver.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, "channel-7", ibctransfertypes.PortID, channelID, clienttypes.NewHeight(1, 100), 0)
+		require.NoError(t, app.TransferKeeper.OnRecvPacket(ctx, packet, data))
+		return ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, channelID, "uatom")).IBCDenom()
+	}
+	counterparty := channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-7")
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-0", channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, counterparty, []string{"connection-0"}, ibctransfertypes.Version))
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctransfertypes.PortID, "channel-2", channeltypes.NewChannel(channeltypes.INIT, channeltypes.UNORDERED, counterparty, []string{"connection-0"}, ibctransfertypes.Version))
+	return voucherOf("channel-0"), voucherOf("channel-1"), voucherOf("channel-2")
+}
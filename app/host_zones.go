@@ -0,0 +1,41 @@
+package app
+
+import (
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// HostZones returns a page of the host zones registered in feeabs, ordered by
+// IBC denom.
+func (app *EveApp) HostZones(ctx sdk.Context, pageReq *query.PageRequest) ([]HostZone, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(feeabstypes.StoreKey)), feeabstypes.KeyHostChainConfigByFeeAbs)
+
+	var hostZones []HostZone
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var config feeabstypes.HostChainFeeAbsConfig
+		if err := app.appCodec.Unmarshal(value, &config); err != nil {
+			return err
+		}
+
+		hostZone := HostZone{
+			IbcDenom:                config.IbcDenom,
+			OsmosisPoolTokenDenomIn: config.OsmosisPoolTokenDenomIn,
+			PoolID:                  config.PoolId,
+			Status:                  config.Status.String(),
+			Frozen:                  config.Status == feeabstypes.HostChainFeeAbsStatus_FROZEN,
+		}
+		if twapRate, err := app.FeeabsKeeper.GetTwapRate(ctx, config.IbcDenom); err == nil {
+			hostZone.TwapRate = twapRate.String()
+		}
+		hostZones = append(hostZones, hostZone)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return hostZones, pageRes, nil
+}
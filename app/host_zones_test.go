@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func setHostZones(t *testing.T, app *EveApp) {
+	t.Helper()
+	ctx := app.NewUncachedContext(false, app.BaseApp.NewContext(false).BlockHeader())
+	require.NoError(t, app.FeeabsKeeper.SetHostZoneConfig(ctx, feeabstypes.HostChainFeeAbsConfig{
+		IbcDenom:                "ibc/atom",
+		OsmosisPoolTokenDenomIn: "ibc/osmoatom",
+		PoolId:                  1,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+	}))
+	app.FeeabsKeeper.SetTwapRate(ctx, "ibc/atom", sdkmath.LegacyNewDec(2))
+	require.NoError(t, app.FeeabsKeeper.SetHostZoneConfig(ctx, feeabstypes.HostChainFeeAbsConfig{
+		IbcDenom:                "ibc/juno",
+		OsmosisPoolTokenDenomIn: "ibc/osmojuno",
+		PoolId:                  2,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_FROZEN,
+	}))
+}
+
+func TestHostZones(t *testing.T) {
+	app := Setup(t)
+	setHostZones(t, app)
+	ctx := app.BaseApp.NewContext(false)
+
+	first, pageRes, err := app.HostZones(ctx, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), pageRes.Total)
+	require.Equal(t, []HostZone{{
+		IbcDenom:                "ibc/atom",
+		OsmosisPoolTokenDenomIn: "ibc/osmoatom",
+		PoolID:                  1,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED.String(),
+		TwapRate:                sdkmath.LegacyNewDec(2).String(),
+	}}, first)
+
+	second, pageRes, err := app.HostZones(ctx, &query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Nil(t, pageRes.NextKey)
+	require.Equal(t, []HostZone{{
+		IbcDenom:                "ibc/juno",
+		OsmosisPoolTokenDenomIn: "ibc/osmojuno",
+		PoolID:                  2,
+		Status:                  feeabstypes.HostChainFeeAbsStatus_FROZEN.String(),
+		Frozen:                  true,
+	}}, second)
+}
+
+func TestHostZonesQuery(t *testing.T) {
+	app := Setup(t)
+	setHostZones(t, app)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	var res QueryHostZonesResponse
+	queryApp(t, app, "HostZones", &QueryHostZonesRequest{Pagination: &query.PageRequest{Limit: 1}}, &res)
+	require.Len(t, res.HostZones, 1)
+	require.Equal(t, "ibc/atom", res.HostZones[0].IbcDenom)
+
+	nextKey := res.Pagination.NextKey
+	res = QueryHostZonesResponse{}
+	queryApp(t, app, "HostZones", &QueryHostZonesRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 1}}, &res)
+	require.Len(t, res.HostZones, 1)
+	require.Equal(t, "ibc/juno", res.HostZones[0].IbcDenom)
+}
+
+func TestHostZonesQueryPageLimit(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	for i := 0; i <= MaxPageLimit; i++ {
+		require.NoError(t, app.FeeabsKeeper.SetHostZoneConfig(ctx, feeabstypes.HostChainFeeAbsConfig{
+			IbcDenom:                fmt.Sprintf("ibc/%03d", i),
+			OsmosisPoolTokenDenomIn: fmt.Sprintf("ibc/osmo%03d", i),
+			PoolId:                  uint64(i + 1),
+			Status:                  feeabstypes.HostChainFeeAbsStatus_UPDATED,
+		}))
+	}
+
+	res, err := queryServer{app: app}.HostZones(ctx, &QueryHostZonesRequest{Pagination: &query.PageRequest{Limit: 10 * MaxPageLimit}})
+	require.NoError(t, err)
+	require.Len(t, res.HostZones, MaxPageLimit)
+	require.NotNil(t, res.Pagination.NextKey)
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"encoding/json"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBCHooksMaxMemoSize is the maximum size in bytes of the memo of a received
+// transfer carrying an ibc-hooks wasm call. It changes the packet
+// acknowledgements, so it is part of consensus and not a node setting.
+const IBCHooksMaxMemoSize = 4096
+
+// transferIBCModule is the set of interfaces implemented by the transfer IBC
+// module, kept by the middleware so the stack still supports channel upgrades
+// and packet data unmarshalling.
+type transferIBCModule interface {
+	porttypes.IBCModule
+	porttypes.UpgradableModule
+	porttypes.PacketDataUnmarshaler
+}
+
+// WasmMemoLimitMiddleware rejects received transfers whose memo carries an
+// ibc-hooks wasm call larger than maxSize bytes with an error acknowledgement,
+// before the packet reaches the transfer module.
+type WasmMemoLimitMiddleware struct {
+	transferIBCModule
+	maxSize int
+}
+
+func NewWasmMemoLimitMiddleware(app transferIBCModule, maxSize int) WasmMemoLimitMiddleware {
+	return WasmMemoLimitMiddleware{transferIBCModule: app, maxSize: maxSize}
+}
+
+func (im WasmMemoLimitMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil &&
+		len(data.Memo) > im.maxSize && isWasmMemo(data.Memo) {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ibc-hooks wasm memo of %d bytes exceeds the maximum of %d", len(data.Memo), im.maxSize))
+	}
+
+	return im.transferIBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// isWasmMemo returns true when the memo is a JSON object with a wasm key, the
+// format ibc-hooks executes.
+func isWasmMemo(memo string) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return false
+	}
+	_, ok := fields["wasm"]
+	return ok
+}
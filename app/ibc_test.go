@@ -0,0 +1,204 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestTransferRegistersDenomMetadata(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	_, _, receiver := testdata.KeyTestPubAddr()
+
+	data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, "channel-7", ibctransfertypes.PortID, "channel-0", clienttypes.NewHeight(1, 100), 0)
+	trace := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-0", "uatom"))
+	voucher := trace.IBCDenom()
+
+	require.False(t, app.BankKeeper.HasDenomMetaData(ctx, voucher))
+	require.NoError(t, app.TransferKeeper.OnRecvPacket(ctx, packet, data))
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, voucher)
+	require.True(t, found)
+	require.Equal(t, voucher, metadata.Base)
+	require.Equal(t, trace.GetFullDenomPath(), metadata.Display)
+	require.Equal(t, "UATOM", metadata.Symbol)
+
+	// a second receipt keeps the metadata as is
+	packet.Sequence++
+	require.NoError(t, app.TransferKeeper.OnRecvPacket(ctx, packet, data))
+	again, found := app.BankKeeper.GetDenomMetaData(ctx, voucher)
+	require.True(t, found)
+	require.Equal(t, metadata, again)
+	require.Len(t, app.BankKeeper.GetAllDenomMetaData(ctx), 1)
+	require.Equal(t, sdkmath.NewInt(2000), app.BankKeeper.GetBalance(ctx, receiver, voucher).Amount)
+}
+
+func TestInterchainAccountRegistrationAndSendTx(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+	owner := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// mock an open connection to an active counterparty client
+	const clientID, connectionID = "07-tendermint-0", "connection-0"
+	height := clienttypes.NewHeight(1, 10)
+	clientState := ibctm.NewClientState("counterparty", ibctm.DefaultTrustLevel, 7*24*time.Hour, 21*24*time.Hour, 10*time.Second, height, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+	app.IBCKeeper.ClientKeeper.SetClientState(ctx, clientID, clientState)
+	app.IBCKeeper.ClientKeeper.SetClientConsensusState(ctx, clientID, height, ibctm.NewConsensusState(ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("root")), make([]byte, 32)))
+	counterparty := connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc")))
+	app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.NewConnectionEnd(connectiontypes.OPEN, clientID, counterparty, connectiontypes.GetCompatibleVersions(), 0))
+
+	deliver := func(msg sdk.Msg) (*sdk.Result, error) {
+		handler := app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler, "no handler for %s", sdk.MsgTypeURL(msg))
+		return handler(ctx, msg)
+	}
+
+	res, err := deliver(icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, owner, ""))
+	require.NoError(t, err)
+	var registered icacontrollertypes.MsgRegisterInterchainAccountResponse
+	require.NoError(t, app.AppCodec().Unmarshal(res.MsgResponses[0].Value, &registered))
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, registered.PortId, registered.ChannelId)
+	require.True(t, found)
+	require.Equal(t, channeltypes.INIT, channel.State)
+
+	// complete the handshake as the counterparty would
+	channel.State = channeltypes.OPEN
+	channel.Counterparty.ChannelId = "channel-0"
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, registered.PortId, registered.ChannelId, channel)
+	_, _, hostAccount := testdata.KeyTestPubAddr()
+	metadata := icatypes.NewMetadata(icatypes.Version, connectionID, connectionID, hostAccount.String(), icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+	icaModule, found := app.IBCKeeper.Router.GetRoute(icacontrollertypes.SubModuleName)
+	require.True(t, found)
+	require.NoError(t, icaModule.OnChanOpenAck(ctx, registered.PortId, registered.ChannelId, "channel-0", string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))))
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, registered.PortId)
+	require.True(t, found)
+	require.Equal(t, hostAccount.String(), address)
+
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []proto.Message{banktypes.NewMsgSend(hostAccount, hostAccount, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))}, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	res, err = deliver(icacontrollertypes.NewMsgSendTx(owner, connectionID, uint64(time.Hour), packetData))
+	require.NoError(t, err)
+	var sent icacontrollertypes.MsgSendTxResponse
+	require.NoError(t, app.AppCodec().Unmarshal(res.MsgResponses[0].Value, &sent))
+	require.Equal(t, uint64(1), sent.Sequence)
+	require.NotEmpty(t, app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, registered.PortId, registered.ChannelId, sent.Sequence))
+}
+
+func TestWasmMemoLimit(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+	transferModule, found := app.IBCKeeper.Router.GetRoute(ibctransfertypes.ModuleName)
+	require.True(t, found)
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(ibctransfertypes.PortID, "channel-0", "uatom")).IBCDenom()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	_, _, relayer := testdata.KeyTestPubAddr()
+
+	// wasmMemo returns a wasm call memo padded to size bytes
+	wasmMemo := func(size int) string {
+		memo := fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{"increment":{}}},"pad":""}`, receiver.String())
+		return memo[:len(memo)-2] + strings.Repeat("a", size-len(memo)) + memo[len(memo)-2:]
+	}
+	testCases := []struct {
+		name       string
+		memo       string
+		expSuccess bool
+	}{
+		{"wasm memo within the limit, should pass", wasmMemo(IBCHooksMaxMemoSize), true},
+		{"wasm memo over the limit, should fail", wasmMemo(IBCHooksMaxMemoSize + 1), false},
+		{"other memo over the limit, should pass", strings.Repeat("a", IBCHooksMaxMemoSize+1), true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := app.BankKeeper.GetBalance(ctx, receiver, voucher).Amount
+			data := ibctransfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", receiver.String(), tc.memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), uint64(i+1), ibctransfertypes.PortID, "channel-7", ibctransfertypes.PortID, "channel-0", clienttypes.NewHeight(1, 100), 0)
+
+			ack := transferModule.OnRecvPacket(ctx, packet, relayer)
+			require.Equal(t, tc.expSuccess, ack.Success(), string(ack.Acknowledgement()))
+			expReceived := sdkmath.ZeroInt()
+			if tc.expSuccess {
+				expReceived = sdkmath.NewInt(1000)
+			}
+			require.Equal(t, before.Add(expReceived), app.BankKeeper.GetBalance(ctx, receiver, voucher).Amount)
+		})
+	}
+}
+
+// recvCounter is an IBC module acknowledging the packets it receives.
+type recvCounter struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *recvCounter) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func TestWasmPacketSizeLimit(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	_, _, relayer := testdata.KeyTestPubAddr()
+	packetOf := func(size int) channeltypes.Packet {
+		return channeltypes.NewPacket(bytes.Repeat([]byte("a"), size), 1, "wasm.contract", "channel-7", "wasm.contract", "channel-0", clienttypes.NewHeight(1, 100), 0)
+	}
+
+	testCases := []struct {
+		name       string
+		size       int
+		expSuccess bool
+	}{
+		{"packet within the limit, should pass", WasmIBCMaxPacketSize, true},
+		{"packet over the limit, should fail", WasmIBCMaxPacketSize + 1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contract := &recvCounter{}
+			ack := NewWasmPacketSizeLimitMiddleware(contract, WasmIBCMaxPacketSize).OnRecvPacket(ctx, packetOf(tc.size), relayer)
+			require.Equal(t, tc.expSuccess, ack.Success())
+			expReceived := 0
+			if tc.expSuccess {
+				expReceived = 1
+			}
+			require.Equal(t, expReceived, contract.received)
+		})
+	}
+
+	// the app's wasm stack enforces the limit
+	wasmModule, found := app.IBCKeeper.Router.GetRoute(wasmtypes.ModuleName)
+	require.True(t, found)
+	ack := wasmModule.OnRecvPacket(ctx, packetOf(WasmIBCMaxPacketSize+1), relayer)
+	require.False(t, ack.Success())
+	limitAck := NewWasmPacketSizeLimitMiddleware(&recvCounter{}, WasmIBCMaxPacketSize).OnRecvPacket(ctx, packetOf(WasmIBCMaxPacketSize+1), relayer)
+	require.Equal(t, limitAck.Acknowledgement(), ack.Acknowledgement())
+}
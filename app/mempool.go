@@ -0,0 +1,279 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+const (
+	// FlagRelayerLaneRatio is the share of mempool.max-txs reserved for IBC relayer txs.
+	FlagRelayerLaneRatio = "mempool.relayer-lane-ratio"
+
+	// DefaultRelayerLaneRatio is used when FlagRelayerLaneRatio is not set.
+	DefaultRelayerLaneRatio = 0.2
+
+	RelayerLaneName = "relayer"
+	DefaultLaneName = "default"
+)
+
+var _ sdkmempool.Mempool = (*LaneMempool)(nil)
+
+// Lane is a partition of the app-side mempool. A tx is stored in the first lane
+// whose Match function accepts it, unless its sender already has txs in
+// another lane.
+type Lane struct {
+	Name    string
+	Match   func(sdk.Tx) bool
+	Mempool sdkmempool.Mempool
+}
+
+// LaneMempool is an app-side mempool made of ordered lanes. Block proposals
+// select every tx of a lane before moving on to the next one, so IBC relaying
+// keeps flowing when the default lane is congested.
+//
+// All the pending txs of a sender are kept in the same lane, the one of its
+// first pending tx, so that the lanes never select a sender's txs out of nonce
+// order.
+type LaneMempool struct {
+	lanes           []Lane
+	signerExtractor sdkmempool.SignerExtractionAdapter
+
+	mtx     sync.Mutex
+	senders map[string]*senderLane
+}
+
+// senderLane is the lane holding the pending txs of a sender.
+type senderLane struct {
+	lane  int
+	count int
+}
+
+// NewLaneMempool returns a LaneMempool with the given lanes, in selection order.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	return &LaneMempool{
+		lanes:           lanes,
+		signerExtractor: sdkmempool.NewDefaultSignerExtractionAdapter(),
+		senders:         make(map[string]*senderLane),
+	}
+}
+
+// NewDefaultLaneMempool returns the relayer and default lanes. maxTxs follows the
+// semantics of mempool.max-txs, with zero meaning unbounded.
+func NewDefaultLaneMempool(maxTxs int, relayerLaneRatio float64) *LaneMempool {
+	relayerMaxTxs, defaultMaxTxs := 0, 0
+	if maxTxs > 0 {
+		relayerMaxTxs = max(int(float64(maxTxs)*relayerLaneRatio), 1)
+		defaultMaxTxs = max(maxTxs-relayerMaxTxs, 1)
+	}
+
+	return NewLaneMempool(
+		Lane{
+			Name:    RelayerLaneName,
+			Match:   IsRelayerTx,
+			Mempool: newPriorityLane(relayerMaxTxs),
+		},
+		Lane{
+			Name:    DefaultLaneName,
+			Match:   func(sdk.Tx) bool { return true },
+			Mempool: newPriorityLane(defaultMaxTxs),
+		},
+	)
+}
+
+func newPriorityLane(maxTxs int) sdkmempool.Mempool {
+	cfg := sdkmempool.DefaultPriorityNonceMempoolConfig()
+	cfg.MaxTx = maxTxs
+	return sdkmempool.NewPriorityMempool(cfg)
+}
+
+// LaneMempoolOption returns a BaseApp option installing the default lane mempool.
+// It returns nil when the app-side mempool is disabled (mempool.max-txs < 0),
+// and an error when the relayer lane ratio isn't a number between 0 and 1.
+func LaneMempoolOption(appOpts servertypes.AppOptions) (func(*baseapp.BaseApp), error) {
+	rawMaxTxs := appOpts.Get(server.FlagMempoolMaxTxs)
+	if rawMaxTxs == nil || cast.ToInt(rawMaxTxs) < 0 {
+		return nil, nil
+	}
+
+	ratio := DefaultRelayerLaneRatio
+	if v := appOpts.Get(FlagRelayerLaneRatio); v != nil {
+		var err error
+		if ratio, err = cast.ToFloat64E(v); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", FlagRelayerLaneRatio, err)
+		}
+	}
+	if ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("invalid %s: %v is not between 0 and 1", FlagRelayerLaneRatio, ratio)
+	}
+
+	return baseapp.SetMempool(NewDefaultLaneMempool(cast.ToInt(rawMaxTxs), ratio)), nil
+}
+
+// IsRelayerTx returns true if every message of the tx is an IBC client update or
+// packet relay message.
+func IsRelayerTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *clienttypes.MsgUpdateClient,
+			*channeltypes.MsgRecvPacket,
+			*channeltypes.MsgAcknowledgement,
+			*channeltypes.MsgTimeout,
+			*channeltypes.MsgTimeoutOnClose:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Insert adds the tx to the lane of its sender's pending txs, or to the first
+// matching lane if the sender has none.
+func (m *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	sender, err := m.sender(tx)
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	i, pending := m.senderLane(sender)
+	if !pending {
+		if i = m.matchLane(tx); i < 0 {
+			return nil
+		}
+	}
+
+	lane := m.lanes[i].Mempool
+	before := lane.CountTx()
+	if err := lane.Insert(ctx, tx); err != nil {
+		return err
+	}
+	// a tx replacing a pending one of the same nonce doesn't add to the count
+	if lane.CountTx() > before {
+		if !pending {
+			m.senders[sender] = &senderLane{lane: i}
+		}
+		m.senders[sender].count++
+	}
+	return nil
+}
+
+// Select returns an iterator over all lanes, in lane order.
+func (m *LaneMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	return newLaneIterator(ctx, txs, m.lanes)
+}
+
+// CountTx returns the number of txs across all lanes.
+func (m *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range m.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes the tx from the lane it was inserted into.
+func (m *LaneMempool) Remove(tx sdk.Tx) error {
+	sender, err := m.sender(tx)
+	if err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	i, pending := m.senderLane(sender)
+	if !pending {
+		return sdkmempool.ErrTxNotFound
+	}
+	if err := m.lanes[i].Mempool.Remove(tx); err != nil {
+		return err
+	}
+	if m.senders[sender].count--; m.senders[sender].count == 0 {
+		delete(m.senders, sender)
+	}
+	return nil
+}
+
+// sender returns the first signer of the tx, the sender whose nonces the lanes
+// order.
+func (m *LaneMempool) sender(tx sdk.Tx) (string, error) {
+	signers, err := m.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return "", err
+	}
+	if len(signers) == 0 {
+		return "", fmt.Errorf("tx must have at least one signer")
+	}
+	return signers[0].Signer.String(), nil
+}
+
+// senderLane returns the index of the lane holding the pending txs of the
+// sender, if any.
+func (m *LaneMempool) senderLane(sender string) (int, bool) {
+	if lane, ok := m.senders[sender]; ok {
+		return lane.lane, true
+	}
+	return 0, false
+}
+
+// matchLane returns the index of the first lane matching the tx, or -1.
+func (m *LaneMempool) matchLane(tx sdk.Tx) int {
+	for i, lane := range m.lanes {
+		if lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Lanes returns the lanes of the mempool, in selection order.
+func (m *LaneMempool) Lanes() []Lane {
+	return m.lanes
+}
+
+type laneIterator struct {
+	ctx   context.Context
+	txs   [][]byte
+	lanes []Lane
+	iter  sdkmempool.Iterator
+}
+
+// newLaneIterator returns an iterator positioned on the first tx of the first
+// non-empty lane, or nil if all lanes are empty.
+func newLaneIterator(ctx context.Context, txs [][]byte, lanes []Lane) sdkmempool.Iterator {
+	for i, lane := range lanes {
+		if iter := lane.Mempool.Select(ctx, txs); iter != nil {
+			return &laneIterator{ctx: ctx, txs: txs, lanes: lanes[i+1:], iter: iter}
+		}
+	}
+	return nil
+}
+
+func (it *laneIterator) Next() sdkmempool.Iterator {
+	if next := it.iter.Next(); next != nil {
+		it.iter = next
+		return it
+	}
+	return newLaneIterator(it.ctx, it.txs, it.lanes)
+}
+
+func (it *laneIterator) Tx() sdk.Tx {
+	return it.iter.Tx()
+}
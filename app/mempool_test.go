@@ -0,0 +1,129 @@
+package app
+
+import (
+	"math/rand"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestLaneMempoolPrioritizesRelayerTxs(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(true)
+	r := rand.New(rand.NewSource(1))
+	mp := NewDefaultLaneMempool(100, DefaultRelayerLaneRatio)
+
+	genTx := func(msg func(sdk.AccAddress) sdk.Msg) sdk.Tx {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		tx, err := simtestutil.GenSignedMockTx(r, app.TxConfig(), []sdk.Msg{msg(addr)}, sdk.Coins{}, simtestutil.DefaultGenTxGas, "testing", []uint64{0}, []uint64{0}, priv)
+		require.NoError(t, err)
+		return tx
+	}
+
+	// flood the mempool with high priority transfers
+	for i := 0; i < 10; i++ {
+		tx := genTx(func(addr sdk.AccAddress) sdk.Msg {
+			return banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		})
+		require.NoError(t, mp.Insert(ctx.WithPriority(1000), tx))
+	}
+
+	relayerTx := genTx(func(addr sdk.AccAddress) sdk.Msg {
+		return &channeltypes.MsgRecvPacket{Signer: addr.String()}
+	})
+	require.True(t, IsRelayerTx(relayerTx))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), relayerTx))
+	require.Equal(t, 11, mp.CountTx())
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+
+	iter := mp.Select(ctx, nil)
+	require.NotNil(t, iter)
+	require.Equal(t, relayerTx, iter.Tx())
+
+	selected := 1
+	for iter = iter.Next(); iter != nil; iter = iter.Next() {
+		require.False(t, IsRelayerTx(iter.Tx()))
+		selected++
+	}
+	require.Equal(t, 11, selected)
+
+	require.NoError(t, mp.Remove(relayerTx))
+	require.Equal(t, 10, mp.CountTx())
+}
+
+func TestLaneMempoolKeepsSenderNonceOrder(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(true)
+	r := rand.New(rand.NewSource(1))
+	mp := NewDefaultLaneMempool(100, DefaultRelayerLaneRatio)
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	genTx := func(msg sdk.Msg, sequence uint64) sdk.Tx {
+		tx, err := simtestutil.GenSignedMockTx(r, app.TxConfig(), []sdk.Msg{msg}, sdk.Coins{}, simtestutil.DefaultGenTxGas, "testing", []uint64{0}, []uint64{sequence}, priv)
+		require.NoError(t, err)
+		return tx
+	}
+	send := genTx(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))), 0)
+	relay := genTx(&channeltypes.MsgRecvPacket{Signer: addr.String()}, 1)
+
+	// the relayer tx follows the pending transfer of its sender in the default
+	// lane, instead of being selected before it
+	require.NoError(t, mp.Insert(ctx, send))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1000), relay))
+	require.Zero(t, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 2, mp.Lanes()[1].Mempool.CountTx())
+
+	var selected []sdk.Tx
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx())
+	}
+	require.Equal(t, []sdk.Tx{send, relay}, selected)
+
+	// once the sender has no pending tx, its relayer txs go to the relayer lane
+	require.NoError(t, mp.Remove(send))
+	require.NoError(t, mp.Remove(relay))
+	require.ErrorIs(t, mp.Remove(relay), sdkmempool.ErrTxNotFound)
+	require.NoError(t, mp.Insert(ctx, genTx(&channeltypes.MsgRecvPacket{Signer: addr.String()}, 2)))
+	require.Equal(t, 1, mp.Lanes()[0].Mempool.CountTx())
+}
+
+func TestLaneMempoolOption(t *testing.T) {
+	testCases := []struct {
+		name   string
+		ratio  any
+		expErr bool
+	}{
+		{"default ratio, should pass", nil, false},
+		{"ratio of 0, should pass", 0, false},
+		{"ratio of 1, should pass", "1", false},
+		{"negative ratio, should fail", -0.1, true},
+		{"ratio above 1, should fail", 1.5, true},
+		{"ratio not a number, should fail", "half", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appOpts := simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: 100}
+			if tc.ratio != nil {
+				appOpts[FlagRelayerLaneRatio] = tc.ratio
+			}
+			opt, err := LaneMempoolOption(appOpts)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, opt)
+		})
+	}
+}
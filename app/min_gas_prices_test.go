@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/eve-network/eve/app/mingasprices"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMinGasPricesParams(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	updateParams := func(msg *mingasprices.MsgUpdateParams) error {
+		handler := app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		_, err := handler(ctx, msg)
+		return err
+	}
+	updated := mingasprices.Params{MinGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("ibcstable", sdkmath.NewInt(1)))}
+	unsorted := sdk.DecCoins{sdk.NewDecCoin("ibcb", sdkmath.NewInt(1)), sdk.NewDecCoin("ibca", sdkmath.NewInt(1))}
+	negative := sdk.DecCoins{{Denom: "ibca", Amount: sdkmath.LegacyNewDec(-1)}}
+	require.ErrorIs(t, updateParams(&mingasprices.MsgUpdateParams{Authority: authority, Params: mingasprices.Params{MinGasPrices: unsorted}}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, updateParams(&mingasprices.MsgUpdateParams{Authority: authority, Params: mingasprices.Params{MinGasPrices: negative}}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, updateParams(&mingasprices.MsgUpdateParams{Authority: authtypes.NewModuleAddress("other").String(), Params: updated}), govtypes.ErrInvalidSigner)
+	require.NoError(t, updateParams(&mingasprices.MsgUpdateParams{Authority: authority, Params: updated}))
+
+	res, err := mingasprices.NewQueryServerImpl(app.MinGasPricesKeeper).Params(ctx, &mingasprices.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, updated, res.Params)
+
+	// the params are exported
+	exported, err := app.MinGasPricesKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, updated, exported.Params)
+}
@@ -0,0 +1,27 @@
+package mingasprices
+
+import (
+	"context"
+)
+
+// DefaultGenesisState returns the default min gas prices genesis state, with
+// no floor.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate validates the genesis parameters.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
+
+// InitGenesis sets the parameters of the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs GenesisState) error {
+	return k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis returns the parameters as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	return &GenesisState{Params: params}, err
+}
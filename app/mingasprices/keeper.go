@@ -0,0 +1,63 @@
+package mingasprices
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// ModuleName is the name of the min gas prices module.
+	ModuleName = "mingasprices"
+
+	// StoreKey is the key of the store holding the min gas prices parameters.
+	StoreKey = ModuleName
+)
+
+// ParamsKey is the store key of the min gas prices parameters.
+var ParamsKey = []byte{0x01}
+
+// Keeper manages the governance controlled gas price floors of the fee denoms.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService corestore.KVStoreService
+	authority    string
+}
+
+// NewKeeper returns the min gas prices keeper. Only authority can update the
+// parameters.
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, authority string) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the min gas prices parameters. They are empty, setting no
+// floor, until set.
+func (k Keeper) GetParams(ctx context.Context) (Params, error) {
+	var params Params
+	bz, err := k.storeService.OpenKVStore(ctx).Get(ParamsKey)
+	if err != nil || bz == nil {
+		return params, err
+	}
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+// SetParams sets the min gas prices parameters.
+func (k Keeper) SetParams(ctx context.Context, params Params) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(ParamsKey, bz)
+}
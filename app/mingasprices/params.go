@@ -0,0 +1,21 @@
+package mingasprices
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// Validate checks that the gas prices are valid, sorted and unique.
+func (p Params) Validate() error {
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
+	}
+	return nil
+}
+
+// GasPriceFloor returns the gas price floor of the fee denom, zero if it has
+// none.
+func (p Params) GasPriceFloor(denom string) sdkmath.LegacyDec {
+	return p.MinGasPrices.AmountOf(denom)
+}
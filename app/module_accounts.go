@@ -0,0 +1,35 @@
+package app
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ModuleAccounts returns all the module accounts of the app sorted by name,
+// with their permissions, whether they are blocked from receiving funds and
+// their current balances.
+func (app *EveApp) ModuleAccounts(ctx sdk.Context) []ModuleAccountInfo {
+	blocked := BlockedAddresses()
+	perms := GetMaccPerms()
+
+	names := make([]string, 0, len(perms))
+	for name := range perms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	accounts := make([]ModuleAccountInfo, 0, len(names))
+	for _, name := range names {
+		addr := authtypes.NewModuleAddress(name)
+		accounts = append(accounts, ModuleAccountInfo{
+			Name:        name,
+			Address:     addr.String(),
+			Permissions: perms[name],
+			Blocked:     blocked[addr.String()],
+			Balances:    app.BankKeeper.GetAllBalances(ctx, addr),
+		})
+	}
+	return accounts
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"testing"
+
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestModuleAccounts(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	accounts := make(map[string]ModuleAccountInfo)
+	for _, acc := range app.ModuleAccounts(ctx) {
+		accounts[acc.Name] = acc
+	}
+	require.Len(t, accounts, len(GetMaccPerms()))
+
+	feeabs := accounts[feeabstypes.ModuleName]
+	require.Equal(t, authtypes.NewModuleAddress(feeabstypes.ModuleName).String(), feeabs.Address)
+	require.Empty(t, feeabs.Permissions)
+	require.False(t, feeabs.Blocked)
+
+	gov := accounts[govtypes.ModuleName]
+	require.Equal(t, []string{authtypes.Burner}, gov.Permissions)
+	require.False(t, gov.Blocked)
+
+	distr := accounts[distrtypes.ModuleName]
+	require.Empty(t, distr.Permissions)
+	require.True(t, distr.Blocked)
+	require.Equal(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName)), distr.Balances)
+}
+
+func TestModuleAccountsQuery(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	var res QueryModuleAccountsResponse
+	queryApp(t, app, "ModuleAccounts", &QueryModuleAccountsRequest{}, &res)
+	expected := app.ModuleAccounts(app.BaseApp.NewContext(true))
+	require.Len(t, res.Accounts, len(expected))
+	for i, acc := range res.Accounts {
+		require.Equal(t, expected[i].Name, acc.Name)
+		require.Equal(t, expected[i].Address, acc.Address)
+		require.Equal(t, len(expected[i].Permissions), len(acc.Permissions))
+		require.Equal(t, expected[i].Blocked, acc.Blocked)
+		require.True(t, expected[i].Balances.Equal(acc.Balances))
+	}
+}
@@ -0,0 +1,6275 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/app/v1/query.proto
+
+package app
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	types1 "cosmossdk.io/x/upgrade/types"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryModuleAccountsRequest is the Query/ModuleAccounts request type.
+type QueryModuleAccountsRequest struct {
+}
+
+func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsRequest{} }
+func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsRequest) ProtoMessage()    {}
+func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{0}
+}
+func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountsRequest proto.InternalMessageInfo
+
+// QueryModuleAccountsResponse is the Query/ModuleAccounts response type.
+type QueryModuleAccountsResponse struct {
+	// accounts lists the module accounts sorted by name.
+	Accounts []ModuleAccountInfo `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccountsResponse{} }
+func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsResponse) ProtoMessage()    {}
+func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{1}
+}
+func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountsResponse) GetAccounts() []ModuleAccountInfo {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// ModuleAccountInfo describes a module account declared in maccPerms.
+type ModuleAccountInfo struct {
+	// name is the name of the module owning the account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address of the module account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// permissions lists the permissions of the module account.
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// blocked is true if the account is blocked from receiving funds.
+	Blocked bool `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// balances are the balances of the module account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *ModuleAccountInfo) Reset()         { *m = ModuleAccountInfo{} }
+func (m *ModuleAccountInfo) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountInfo) ProtoMessage()    {}
+func (*ModuleAccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{2}
+}
+func (m *ModuleAccountInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountInfo.Merge(m, src)
+}
+func (m *ModuleAccountInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountInfo proto.InternalMessageInfo
+
+func (m *ModuleAccountInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleAccountInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ModuleAccountInfo) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *ModuleAccountInfo) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *ModuleAccountInfo) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// QueryUpgradeInfoRequest is the Query/UpgradeInfo request type.
+type QueryUpgradeInfoRequest struct {
+}
+
+func (m *QueryUpgradeInfoRequest) Reset()         { *m = QueryUpgradeInfoRequest{} }
+func (m *QueryUpgradeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeInfoRequest) ProtoMessage()    {}
+func (*QueryUpgradeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{3}
+}
+func (m *QueryUpgradeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeInfoRequest.Merge(m, src)
+}
+func (m *QueryUpgradeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeInfoRequest proto.InternalMessageInfo
+
+// QueryUpgradeInfoResponse is the Query/UpgradeInfo response type.
+type QueryUpgradeInfoResponse struct {
+	// upgrade_info is the upgrade status of the chain.
+	UpgradeInfo UpgradeInfo `protobuf:"bytes,1,opt,name=upgrade_info,json=upgradeInfo,proto3" json:"upgrade_info"`
+}
+
+func (m *QueryUpgradeInfoResponse) Reset()         { *m = QueryUpgradeInfoResponse{} }
+func (m *QueryUpgradeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeInfoResponse) ProtoMessage()    {}
+func (*QueryUpgradeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{4}
+}
+func (m *QueryUpgradeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeInfoResponse.Merge(m, src)
+}
+func (m *QueryUpgradeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeInfoResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeInfoResponse) GetUpgradeInfo() UpgradeInfo {
+	if m != nil {
+		return m.UpgradeInfo
+	}
+	return UpgradeInfo{}
+}
+
+// UpgradeInfo summarizes the upgrade status of the chain.
+type UpgradeInfo struct {
+	// plan is the scheduled upgrade plan, unset if there is none.
+	Plan *types1.Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// applied lists the upgrades applied by the chain ordered by height.
+	Applied []AppliedUpgrade `protobuf:"bytes,2,rep,name=applied,proto3" json:"applied"`
+	// module_versions are the consensus versions of the modules sorted by name.
+	ModuleVersions []*types1.ModuleVersion `protobuf:"bytes,3,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions,omitempty"`
+}
+
+func (m *UpgradeInfo) Reset()         { *m = UpgradeInfo{} }
+func (m *UpgradeInfo) String() string { return proto.CompactTextString(m) }
+func (*UpgradeInfo) ProtoMessage()    {}
+func (*UpgradeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{5}
+}
+func (m *UpgradeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeInfo.Merge(m, src)
+}
+func (m *UpgradeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeInfo proto.InternalMessageInfo
+
+func (m *UpgradeInfo) GetPlan() *types1.Plan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *UpgradeInfo) GetApplied() []AppliedUpgrade {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *UpgradeInfo) GetModuleVersions() []*types1.ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
+// AppliedUpgrade is an upgrade executed by the chain.
+type AppliedUpgrade struct {
+	// name is the name of the upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the height at which the upgrade was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AppliedUpgrade) Reset()         { *m = AppliedUpgrade{} }
+func (m *AppliedUpgrade) String() string { return proto.CompactTextString(m) }
+func (*AppliedUpgrade) ProtoMessage()    {}
+func (*AppliedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{6}
+}
+func (m *AppliedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppliedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedUpgrade.Merge(m, src)
+}
+func (m *AppliedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedUpgrade proto.InternalMessageInfo
+
+func (m *AppliedUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppliedUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryStoreHashesRequest is the Query/StoreHashes request type.
+type QueryStoreHashesRequest struct {
+	// height is the committed height of the hashes, 0 for the latest one.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryStoreHashesRequest) Reset()         { *m = QueryStoreHashesRequest{} }
+func (m *QueryStoreHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreHashesRequest) ProtoMessage()    {}
+func (*QueryStoreHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{7}
+}
+func (m *QueryStoreHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreHashesRequest.Merge(m, src)
+}
+func (m *QueryStoreHashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreHashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreHashesRequest proto.InternalMessageInfo
+
+func (m *QueryStoreHashesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryStoreHashesResponse is the Query/StoreHashes response type.
+type QueryStoreHashesResponse struct {
+	// height is the committed height of the hashes.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// stores lists the commit hash of each store sorted by name.
+	Stores []StoreHash `protobuf:"bytes,2,rep,name=stores,proto3" json:"stores"`
+}
+
+func (m *QueryStoreHashesResponse) Reset()         { *m = QueryStoreHashesResponse{} }
+func (m *QueryStoreHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoreHashesResponse) ProtoMessage()    {}
+func (*QueryStoreHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{8}
+}
+func (m *QueryStoreHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoreHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoreHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoreHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoreHashesResponse.Merge(m, src)
+}
+func (m *QueryStoreHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoreHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoreHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStoreHashesResponse proto.InternalMessageInfo
+
+func (m *QueryStoreHashesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryStoreHashesResponse) GetStores() []StoreHash {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// StoreHash is the commit hash of a single store.
+type StoreHash struct {
+	// name is the name of the store.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hash is the commit hash of the store.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *StoreHash) Reset()         { *m = StoreHash{} }
+func (m *StoreHash) String() string { return proto.CompactTextString(m) }
+func (*StoreHash) ProtoMessage()    {}
+func (*StoreHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{9}
+}
+func (m *StoreHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreHash.Merge(m, src)
+}
+func (m *StoreHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreHash proto.InternalMessageInfo
+
+func (m *StoreHash) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoreHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// QueryHostZonesRequest is the Query/HostZones request type.
+type QueryHostZonesRequest struct {
+	// pagination defines an optional pagination for the request. Pages hold at
+	// most 100 host zones.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHostZonesRequest) Reset()         { *m = QueryHostZonesRequest{} }
+func (m *QueryHostZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZonesRequest) ProtoMessage()    {}
+func (*QueryHostZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{10}
+}
+func (m *QueryHostZonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZonesRequest.Merge(m, src)
+}
+func (m *QueryHostZonesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZonesRequest proto.InternalMessageInfo
+
+func (m *QueryHostZonesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHostZonesResponse is the Query/HostZones response type.
+type QueryHostZonesResponse struct {
+	// ibc_transfer_channel is the channel to Osmosis used to swap the fees.
+	IbcTransferChannel string `protobuf:"bytes,1,opt,name=ibc_transfer_channel,json=ibcTransferChannel,proto3" json:"ibc_transfer_channel,omitempty"`
+	// ibc_query_icq_channel is the channel used to query the twap rates.
+	IbcQueryIcqChannel string `protobuf:"bytes,2,opt,name=ibc_query_icq_channel,json=ibcQueryIcqChannel,proto3" json:"ibc_query_icq_channel,omitempty"`
+	// host_zones lists the host zones of the page.
+	HostZones []HostZone `protobuf:"bytes,3,rep,name=host_zones,json=hostZones,proto3" json:"host_zones"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHostZonesResponse) Reset()         { *m = QueryHostZonesResponse{} }
+func (m *QueryHostZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZonesResponse) ProtoMessage()    {}
+func (*QueryHostZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{11}
+}
+func (m *QueryHostZonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZonesResponse.Merge(m, src)
+}
+func (m *QueryHostZonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZonesResponse proto.InternalMessageInfo
+
+func (m *QueryHostZonesResponse) GetIbcTransferChannel() string {
+	if m != nil {
+		return m.IbcTransferChannel
+	}
+	return ""
+}
+
+func (m *QueryHostZonesResponse) GetIbcQueryIcqChannel() string {
+	if m != nil {
+		return m.IbcQueryIcqChannel
+	}
+	return ""
+}
+
+func (m *QueryHostZonesResponse) GetHostZones() []HostZone {
+	if m != nil {
+		return m.HostZones
+	}
+	return nil
+}
+
+func (m *QueryHostZonesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// HostZone is the fee abstraction config of a host zone along with its
+// current twap rate.
+type HostZone struct {
+	// ibc_denom is the IBC denom of the fee token on Eve.
+	IbcDenom string `protobuf:"bytes,1,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+	// osmosis_pool_token_denom_in is the denom of the fee token on Osmosis.
+	OsmosisPoolTokenDenomIn string `protobuf:"bytes,2,opt,name=osmosis_pool_token_denom_in,json=osmosisPoolTokenDenomIn,proto3" json:"osmosis_pool_token_denom_in,omitempty"`
+	// pool_id is the id of the Osmosis pool used for the twap rate.
+	PoolID uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// status is the status of the host zone.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// frozen is true if the host zone is frozen.
+	Frozen bool `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// twap_rate is the current twap rate, empty if none was received yet.
+	TwapRate string `protobuf:"bytes,6,opt,name=twap_rate,json=twapRate,proto3" json:"twap_rate,omitempty"`
+}
+
+func (m *HostZone) Reset()         { *m = HostZone{} }
+func (m *HostZone) String() string { return proto.CompactTextString(m) }
+func (*HostZone) ProtoMessage()    {}
+func (*HostZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{12}
+}
+func (m *HostZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZone.Merge(m, src)
+}
+func (m *HostZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZone proto.InternalMessageInfo
+
+func (m *HostZone) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
+	}
+	return ""
+}
+
+func (m *HostZone) GetOsmosisPoolTokenDenomIn() string {
+	if m != nil {
+		return m.OsmosisPoolTokenDenomIn
+	}
+	return ""
+}
+
+func (m *HostZone) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *HostZone) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *HostZone) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *HostZone) GetTwapRate() string {
+	if m != nil {
+		return m.TwapRate
+	}
+	return ""
+}
+
+// QueryFeeMarketRequest is the Query/FeeMarket request type.
+type QueryFeeMarketRequest struct {
+}
+
+func (m *QueryFeeMarketRequest) Reset()         { *m = QueryFeeMarketRequest{} }
+func (m *QueryFeeMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeMarketRequest) ProtoMessage()    {}
+func (*QueryFeeMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{13}
+}
+func (m *QueryFeeMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeMarketRequest.Merge(m, src)
+}
+func (m *QueryFeeMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeMarketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeMarketRequest proto.InternalMessageInfo
+
+// QueryFeeMarketResponse is the Query/FeeMarket response type.
+type QueryFeeMarketResponse struct {
+	// fee_market is the fee market state.
+	FeeMarket FeeMarketInfo `protobuf:"bytes,1,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market"`
+}
+
+func (m *QueryFeeMarketResponse) Reset()         { *m = QueryFeeMarketResponse{} }
+func (m *QueryFeeMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeMarketResponse) ProtoMessage()    {}
+func (*QueryFeeMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{14}
+}
+func (m *QueryFeeMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeMarketResponse.Merge(m, src)
+}
+func (m *QueryFeeMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeMarketResponse proto.InternalMessageInfo
+
+func (m *QueryFeeMarketResponse) GetFeeMarket() FeeMarketInfo {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return FeeMarketInfo{}
+}
+
+// FeeMarketInfo is the fee market state wallets need to estimate fees. The gas
+// prices are the ones the ante handler enforces in the next block.
+type FeeMarketInfo struct {
+	// enabled is true if the fee market is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// fee_denom is the native fee denom.
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// base_gas_price is the current base gas price in the fee denom.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// min_base_gas_price is the floor of the base gas price.
+	MinBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_gas_price"`
+	// learning_rate is the current learning rate.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// block_utilization is the gas used by the last block.
+	BlockUtilization uint64 `protobuf:"varint,6,opt,name=block_utilization,json=blockUtilization,proto3" json:"block_utilization,omitempty"`
+	// target_block_utilization is the gas the fee market targets per block.
+	TargetBlockUtilization uint64 `protobuf:"varint,7,opt,name=target_block_utilization,json=targetBlockUtilization,proto3" json:"target_block_utilization,omitempty"`
+	// max_block_utilization is the maximum gas per block.
+	MaxBlockUtilization uint64 `protobuf:"varint,8,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
+	// min_gas_prices are the minimum gas prices in every accepted fee denom.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+}
+
+func (m *FeeMarketInfo) Reset()         { *m = FeeMarketInfo{} }
+func (m *FeeMarketInfo) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketInfo) ProtoMessage()    {}
+func (*FeeMarketInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{15}
+}
+func (m *FeeMarketInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketInfo.Merge(m, src)
+}
+func (m *FeeMarketInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketInfo proto.InternalMessageInfo
+
+func (m *FeeMarketInfo) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketInfo) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *FeeMarketInfo) GetBlockUtilization() uint64 {
+	if m != nil {
+		return m.BlockUtilization
+	}
+	return 0
+}
+
+func (m *FeeMarketInfo) GetTargetBlockUtilization() uint64 {
+	if m != nil {
+		return m.TargetBlockUtilization
+	}
+	return 0
+}
+
+func (m *FeeMarketInfo) GetMaxBlockUtilization() uint64 {
+	if m != nil {
+		return m.MaxBlockUtilization
+	}
+	return 0
+}
+
+func (m *FeeMarketInfo) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+// QueryAccountPositionRequest is the Query/AccountPosition request type.
+type QueryAccountPositionRequest struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountPositionRequest) Reset()         { *m = QueryAccountPositionRequest{} }
+func (m *QueryAccountPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPositionRequest) ProtoMessage()    {}
+func (*QueryAccountPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{16}
+}
+func (m *QueryAccountPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPositionRequest.Merge(m, src)
+}
+func (m *QueryAccountPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPositionRequest proto.InternalMessageInfo
+
+func (m *QueryAccountPositionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountPositionResponse is the Query/AccountPosition response type.
+type QueryAccountPositionResponse struct {
+	// position is the position of the account.
+	Position AccountPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+}
+
+func (m *QueryAccountPositionResponse) Reset()         { *m = QueryAccountPositionResponse{} }
+func (m *QueryAccountPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPositionResponse) ProtoMessage()    {}
+func (*QueryAccountPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{17}
+}
+func (m *QueryAccountPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPositionResponse.Merge(m, src)
+}
+func (m *QueryAccountPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountPositionResponse proto.InternalMessageInfo
+
+func (m *QueryAccountPositionResponse) GetPosition() AccountPosition {
+	if m != nil {
+		return m.Position
+	}
+	return AccountPosition{}
+}
+
+// AccountPosition is the financial position of an account.
+type AccountPosition struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balances are the liquid balances of the account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// delegations lists the delegations of the account.
+	Delegations []DelegationPosition `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations"`
+	// unbondings lists the unbonding delegation entries of the account.
+	Unbondings []UnbondingPosition `protobuf:"bytes,4,rep,name=unbondings,proto3" json:"unbondings"`
+	// rewards are the pending staking rewards of the account.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *AccountPosition) Reset()         { *m = AccountPosition{} }
+func (m *AccountPosition) String() string { return proto.CompactTextString(m) }
+func (*AccountPosition) ProtoMessage()    {}
+func (*AccountPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{18}
+}
+func (m *AccountPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPosition.Merge(m, src)
+}
+func (m *AccountPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPosition proto.InternalMessageInfo
+
+func (m *AccountPosition) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountPosition) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *AccountPosition) GetDelegations() []DelegationPosition {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *AccountPosition) GetUnbondings() []UnbondingPosition {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *AccountPosition) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// DelegationPosition is a delegation along with the tokens its shares are
+// worth.
+type DelegationPosition struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the delegation shares.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// balance is the amount of tokens the shares are worth.
+	Balance types.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *DelegationPosition) Reset()         { *m = DelegationPosition{} }
+func (m *DelegationPosition) String() string { return proto.CompactTextString(m) }
+func (*DelegationPosition) ProtoMessage()    {}
+func (*DelegationPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{19}
+}
+func (m *DelegationPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPosition.Merge(m, src)
+}
+func (m *DelegationPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPosition proto.InternalMessageInfo
+
+func (m *DelegationPosition) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationPosition) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+// UnbondingPosition is an unbonding delegation entry.
+type UnbondingPosition struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// balance is the amount of tokens to receive at completion.
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// completion_time is the time at which the unbonding completes.
+	CompletionTime time.Time `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *UnbondingPosition) Reset()         { *m = UnbondingPosition{} }
+func (m *UnbondingPosition) String() string { return proto.CompactTextString(m) }
+func (*UnbondingPosition) ProtoMessage()    {}
+func (*UnbondingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{20}
+}
+func (m *UnbondingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingPosition.Merge(m, src)
+}
+func (m *UnbondingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingPosition proto.InternalMessageInfo
+
+func (m *UnbondingPosition) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *UnbondingPosition) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingPosition) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// QueryConsensusParamsMigrationRequest is the Query/ConsensusParamsMigration
+// request type.
+type QueryConsensusParamsMigrationRequest struct {
+}
+
+func (m *QueryConsensusParamsMigrationRequest) Reset()         { *m = QueryConsensusParamsMigrationRequest{} }
+func (m *QueryConsensusParamsMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusParamsMigrationRequest) ProtoMessage()    {}
+func (*QueryConsensusParamsMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{21}
+}
+func (m *QueryConsensusParamsMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusParamsMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusParamsMigrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusParamsMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusParamsMigrationRequest.Merge(m, src)
+}
+func (m *QueryConsensusParamsMigrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusParamsMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusParamsMigrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusParamsMigrationRequest proto.InternalMessageInfo
+
+// QueryConsensusParamsMigrationResponse is the Query/ConsensusParamsMigration
+// response type.
+type QueryConsensusParamsMigrationResponse struct {
+	// migration is the outcome of the consensus params migration.
+	Migration ConsensusParamsMigration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration"`
+}
+
+func (m *QueryConsensusParamsMigrationResponse) Reset()         { *m = QueryConsensusParamsMigrationResponse{} }
+func (m *QueryConsensusParamsMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusParamsMigrationResponse) ProtoMessage()    {}
+func (*QueryConsensusParamsMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{22}
+}
+func (m *QueryConsensusParamsMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusParamsMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusParamsMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusParamsMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusParamsMigrationResponse.Merge(m, src)
+}
+func (m *QueryConsensusParamsMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusParamsMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusParamsMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusParamsMigrationResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusParamsMigrationResponse) GetMigration() ConsensusParamsMigration {
+	if m != nil {
+		return m.Migration
+	}
+	return ConsensusParamsMigration{}
+}
+
+// ConsensusParamsMigration is the outcome of the consensus params migration
+// run with the first block finalized since the node started.
+type ConsensusParamsMigration struct {
+	// checked is false until the node finalized a block.
+	Checked bool `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	// migrated is true if the consensus params were migrated from x/params.
+	Migrated bool `protobuf:"varint,2,opt,name=migrated,proto3" json:"migrated,omitempty"`
+}
+
+func (m *ConsensusParamsMigration) Reset()         { *m = ConsensusParamsMigration{} }
+func (m *ConsensusParamsMigration) String() string { return proto.CompactTextString(m) }
+func (*ConsensusParamsMigration) ProtoMessage()    {}
+func (*ConsensusParamsMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5abce7913d748d5e, []int{23}
+}
+func (m *ConsensusParamsMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusParamsMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusParamsMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusParamsMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusParamsMigration.Merge(m, src)
+}
+func (m *ConsensusParamsMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusParamsMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusParamsMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusParamsMigration proto.InternalMessageInfo
+
+func (m *ConsensusParamsMigration) GetChecked() bool {
+	if m != nil {
+		return m.Checked
+	}
+	return false
+}
+
+func (m *ConsensusParamsMigration) GetMigrated() bool {
+	if m != nil {
+		return m.Migrated
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryModuleAccountsRequest)(nil), "eve.app.v1.QueryModuleAccountsRequest")
+	proto.RegisterType((*QueryModuleAccountsResponse)(nil), "eve.app.v1.QueryModuleAccountsResponse")
+	proto.RegisterType((*ModuleAccountInfo)(nil), "eve.app.v1.ModuleAccountInfo")
+	proto.RegisterType((*QueryUpgradeInfoRequest)(nil), "eve.app.v1.QueryUpgradeInfoRequest")
+	proto.RegisterType((*QueryUpgradeInfoResponse)(nil), "eve.app.v1.QueryUpgradeInfoResponse")
+	proto.RegisterType((*UpgradeInfo)(nil), "eve.app.v1.UpgradeInfo")
+	proto.RegisterType((*AppliedUpgrade)(nil), "eve.app.v1.AppliedUpgrade")
+	proto.RegisterType((*QueryStoreHashesRequest)(nil), "eve.app.v1.QueryStoreHashesRequest")
+	proto.RegisterType((*QueryStoreHashesResponse)(nil), "eve.app.v1.QueryStoreHashesResponse")
+	proto.RegisterType((*StoreHash)(nil), "eve.app.v1.StoreHash")
+	proto.RegisterType((*QueryHostZonesRequest)(nil), "eve.app.v1.QueryHostZonesRequest")
+	proto.RegisterType((*QueryHostZonesResponse)(nil), "eve.app.v1.QueryHostZonesResponse")
+	proto.RegisterType((*HostZone)(nil), "eve.app.v1.HostZone")
+	proto.RegisterType((*QueryFeeMarketRequest)(nil), "eve.app.v1.QueryFeeMarketRequest")
+	proto.RegisterType((*QueryFeeMarketResponse)(nil), "eve.app.v1.QueryFeeMarketResponse")
+	proto.RegisterType((*FeeMarketInfo)(nil), "eve.app.v1.FeeMarketInfo")
+	proto.RegisterType((*QueryAccountPositionRequest)(nil), "eve.app.v1.QueryAccountPositionRequest")
+	proto.RegisterType((*QueryAccountPositionResponse)(nil), "eve.app.v1.QueryAccountPositionResponse")
+	proto.RegisterType((*AccountPosition)(nil), "eve.app.v1.AccountPosition")
+	proto.RegisterType((*DelegationPosition)(nil), "eve.app.v1.DelegationPosition")
+	proto.RegisterType((*UnbondingPosition)(nil), "eve.app.v1.UnbondingPosition")
+	proto.RegisterType((*QueryConsensusParamsMigrationRequest)(nil), "eve.app.v1.QueryConsensusParamsMigrationRequest")
+	proto.RegisterType((*QueryConsensusParamsMigrationResponse)(nil), "eve.app.v1.QueryConsensusParamsMigrationResponse")
+	proto.RegisterType((*ConsensusParamsMigration)(nil), "eve.app.v1.ConsensusParamsMigration")
+}
+
+func init() { proto.RegisterFile("eve/app/v1/query.proto", fileDescriptor_5abce7913d748d5e) }
+
+var fileDescriptor_5abce7913d748d5e = []byte{
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x52, 0xb4, 0x48, 0x3e, 0x39, 0x72, 0x3c, 0xb5, 0xe5, 0x35, 0x65, 0x53, 0xcc, 0x5a,
+	0x71, 0x88, 0x06, 0x5a, 0x9a, 0xf2, 0x25, 0x29, 0xd2, 0x3f, 0xa6, 0x05, 0xc7, 0x02, 0xea, 0x40,
+	0xd9, 0xd8, 0x6e, 0x11, 0xb4, 0x59, 0x0c, 0x77, 0x87, 0xcb, 0x85, 0x76, 0x67, 0x56, 0x3b, 0x4b,
+	0x3a, 0x76, 0x51, 0xa0, 0xed, 0xa1, 0xe7, 0x00, 0xed, 0x87, 0x28, 0x7a, 0xe9, 0xc5, 0x97, 0x7e,
+	0x83, 0x00, 0xbd, 0x04, 0xee, 0xa1, 0x45, 0x0f, 0x4e, 0x61, 0xf7, 0xd4, 0xa2, 0xdf, 0xa1, 0x98,
+	0xd9, 0x19, 0x72, 0xf9, 0x47, 0x54, 0x22, 0xd4, 0x27, 0x72, 0xe6, 0xbd, 0xdf, 0xfb, 0xbf, 0x6f,
+	0xde, 0x83, 0x0d, 0x32, 0x22, 0x6d, 0x9c, 0x24, 0xed, 0x51, 0xa7, 0x7d, 0x34, 0x24, 0xe9, 0x13,
+	0x3b, 0x49, 0x59, 0xc6, 0x10, 0x90, 0x11, 0xb1, 0x71, 0x92, 0xd8, 0xa3, 0x4e, 0xfd, 0xbb, 0x1e,
+	0xe3, 0x31, 0xe3, 0xed, 0x1e, 0xe6, 0x24, 0x67, 0x6a, 0x8f, 0x3a, 0x3d, 0x92, 0xe1, 0x4e, 0x3b,
+	0xc1, 0x41, 0x48, 0x71, 0x16, 0x32, 0x9a, 0xe3, 0xea, 0x8d, 0x22, 0xaf, 0xe6, 0xf2, 0x58, 0xa8,
+	0xe9, 0xdb, 0x8a, 0x3e, 0x4c, 0x82, 0x14, 0xfb, 0x13, 0x16, 0x75, 0x56, 0x5c, 0x57, 0x72, 0x2e,
+	0x57, 0x9e, 0xda, 0xf9, 0x41, 0x91, 0x2e, 0x06, 0x2c, 0x60, 0xf9, 0xbd, 0xf8, 0xa7, 0x6e, 0xaf,
+	0x06, 0x8c, 0x05, 0x91, 0xf0, 0x24, 0x6c, 0x63, 0x4a, 0x59, 0x26, 0x6d, 0xd2, 0x98, 0x2d, 0x45,
+	0x95, 0xa7, 0xde, 0xb0, 0xdf, 0xce, 0xc2, 0x98, 0xf0, 0x0c, 0xc7, 0x49, 0xce, 0x60, 0x5d, 0x85,
+	0xfa, 0xc7, 0xc2, 0xaf, 0xfb, 0xcc, 0x1f, 0x46, 0xe4, 0xb6, 0xe7, 0xb1, 0x21, 0xcd, 0xb8, 0x43,
+	0x8e, 0x86, 0x84, 0x67, 0xd6, 0x67, 0xb0, 0xb9, 0x90, 0xca, 0x13, 0x46, 0x39, 0x41, 0x3f, 0x84,
+	0x2a, 0x56, 0x77, 0xa6, 0xd1, 0x5c, 0x69, 0xad, 0xed, 0x5e, 0xb3, 0x27, 0xd1, 0xb3, 0xa7, 0x50,
+	0xfb, 0xb4, 0xcf, 0xba, 0xe5, 0x2f, 0x5f, 0x6c, 0x9d, 0x71, 0xc6, 0x20, 0xeb, 0x57, 0x25, 0xb8,
+	0x30, 0xc7, 0x85, 0x10, 0x94, 0x29, 0x8e, 0x89, 0x69, 0x34, 0x8d, 0x56, 0xcd, 0x91, 0xff, 0xd1,
+	0x2e, 0x54, 0xb0, 0xef, 0xa7, 0x84, 0x73, 0xb3, 0x24, 0xae, 0xbb, 0xe6, 0xf3, 0x67, 0x3b, 0x17,
+	0x55, 0x7c, 0x6e, 0xe7, 0x94, 0x4f, 0xb2, 0x34, 0xa4, 0x81, 0xa3, 0x19, 0x51, 0x13, 0xd6, 0x12,
+	0x92, 0xc6, 0x21, 0xe7, 0x22, 0x22, 0xe6, 0x4a, 0x73, 0xa5, 0x55, 0x73, 0x8a, 0x57, 0xc8, 0x84,
+	0x4a, 0x2f, 0x62, 0xde, 0x21, 0xf1, 0xcd, 0x72, 0xd3, 0x68, 0x55, 0x1d, 0x7d, 0x44, 0x01, 0x54,
+	0x7b, 0x38, 0xc2, 0xd4, 0x23, 0xdc, 0x3c, 0x2b, 0x5d, 0xbb, 0x62, 0x2b, 0x6d, 0x22, 0xc1, 0xb6,
+	0xca, 0x9e, 0x7d, 0x87, 0x85, 0xb4, 0x7b, 0x53, 0xb8, 0xf5, 0xc7, 0xaf, 0xb7, 0x5a, 0x41, 0x98,
+	0x0d, 0x86, 0x3d, 0xdb, 0x63, 0xb1, 0x4a, 0x9d, 0xfa, 0xd9, 0xe1, 0xfe, 0x61, 0x3b, 0x7b, 0x92,
+	0x10, 0x2e, 0x01, 0xdc, 0x19, 0x0b, 0xb7, 0xae, 0xc0, 0x65, 0x19, 0xe2, 0x87, 0x79, 0x19, 0x88,
+	0x00, 0xe8, 0xe8, 0xff, 0x0c, 0xcc, 0x79, 0x92, 0x0a, 0xfd, 0x8f, 0xe0, 0x9c, 0x2a, 0x1c, 0x37,
+	0xa4, 0x7d, 0x26, 0x63, 0xb5, 0xb6, 0x7b, 0xb9, 0x18, 0xfe, 0x02, 0x4c, 0x05, 0x7e, 0x6d, 0x38,
+	0xb9, 0xb2, 0xfe, 0x62, 0xc0, 0x5a, 0x81, 0x05, 0xdd, 0x84, 0x72, 0x12, 0x61, 0xaa, 0x24, 0x5d,
+	0xd5, 0xde, 0xea, 0xf2, 0xd4, 0x0e, 0x1f, 0x44, 0x98, 0x3a, 0x92, 0x13, 0x7d, 0x0f, 0x2a, 0x38,
+	0x49, 0xa2, 0x90, 0xf8, 0x66, 0x49, 0x86, 0xa8, 0x5e, 0x54, 0x7f, 0x3b, 0x27, 0x29, 0x15, 0xca,
+	0x02, 0x0d, 0x40, 0x1f, 0xc1, 0xf9, 0x58, 0x26, 0xde, 0x1d, 0x91, 0x74, 0x92, 0x9f, 0xb5, 0xdd,
+	0xb7, 0x8f, 0x53, 0x9c, 0xd7, 0xc9, 0xa3, 0x9c, 0xdb, 0x59, 0x8f, 0x8b, 0x47, 0x6e, 0x7d, 0x00,
+	0xeb, 0xd3, 0x0a, 0x17, 0x56, 0xd1, 0x06, 0xac, 0x0e, 0x48, 0x18, 0x0c, 0x32, 0x59, 0x44, 0x2b,
+	0x8e, 0x3a, 0x59, 0x1d, 0x95, 0x84, 0x4f, 0x32, 0x96, 0x92, 0x7b, 0x98, 0x0f, 0x88, 0xfe, 0x04,
+	0x0a, 0x10, 0x63, 0x0a, 0x12, 0x80, 0x39, 0x0f, 0x51, 0xc9, 0x39, 0x06, 0x83, 0x6e, 0xc1, 0x2a,
+	0x17, 0xec, 0x5c, 0xc5, 0xeb, 0x52, 0x31, 0x5e, 0x63, 0x41, 0x2a, 0x54, 0x8a, 0xd5, 0xba, 0x05,
+	0xb5, 0x31, 0x69, 0xa1, 0x53, 0x08, 0xca, 0x03, 0xcc, 0x07, 0xd2, 0xa5, 0x73, 0x8e, 0xfc, 0x6f,
+	0xb9, 0x70, 0x49, 0x5a, 0x77, 0x8f, 0xf1, 0xec, 0x53, 0x46, 0x27, 0xee, 0xdc, 0x05, 0x98, 0x74,
+	0x2e, 0x95, 0xeb, 0x1b, 0x53, 0x95, 0x9d, 0xf7, 0xc2, 0x71, 0xba, 0x71, 0x40, 0x14, 0xd6, 0x29,
+	0x20, 0xad, 0x5f, 0x97, 0x60, 0x63, 0x56, 0x83, 0xf2, 0xfe, 0x26, 0x5c, 0x0c, 0x7b, 0x9e, 0x9b,
+	0xa5, 0x98, 0xf2, 0x3e, 0x49, 0x5d, 0x6f, 0x80, 0x29, 0x25, 0x91, 0xb2, 0x19, 0x85, 0x3d, 0xef,
+	0x81, 0x22, 0xdd, 0xc9, 0x29, 0xa8, 0x03, 0x97, 0x04, 0x42, 0x6a, 0x76, 0x43, 0xef, 0x68, 0x0c,
+	0x29, 0x8d, 0x21, 0x52, 0xd7, 0xbe, 0x77, 0xa4, 0x21, 0xef, 0x03, 0x0c, 0x18, 0xcf, 0xdc, 0xa7,
+	0x42, 0xb5, 0x2a, 0x9d, 0x8b, 0xc5, 0x70, 0x6a, 0xbb, 0x54, 0x34, 0x6b, 0x03, 0x6d, 0x27, 0xfa,
+	0x70, 0x2a, 0x04, 0x65, 0x19, 0x82, 0x77, 0x4e, 0x0c, 0x41, 0xee, 0xdc, 0x54, 0x0c, 0xfe, 0x66,
+	0x40, 0x55, 0xab, 0x41, 0x9b, 0x50, 0x13, 0x3e, 0xf8, 0x84, 0xb2, 0x58, 0xb9, 0x5a, 0x0d, 0x7b,
+	0xde, 0x9e, 0x38, 0xa3, 0x0f, 0x60, 0x53, 0x8a, 0x0f, 0xb9, 0x9b, 0x30, 0x16, 0xb9, 0x19, 0x3b,
+	0x24, 0x34, 0xe7, 0x75, 0x43, 0xaa, 0xdc, 0xbc, 0xac, 0x58, 0x0e, 0x18, 0x8b, 0x1e, 0x08, 0x06,
+	0x89, 0xdd, 0xa7, 0xe8, 0x3a, 0x54, 0x24, 0x2a, 0xf4, 0xcd, 0x95, 0xa6, 0xd1, 0x2a, 0x77, 0xe1,
+	0xe5, 0x8b, 0xad, 0x55, 0xc1, 0xb6, 0xbf, 0xe7, 0xac, 0x0a, 0xd2, 0xbe, 0x2f, 0x6a, 0x8e, 0x67,
+	0x38, 0x1b, 0x72, 0xe9, 0x51, 0xcd, 0x51, 0x27, 0x71, 0xdf, 0x4f, 0xd9, 0x53, 0x42, 0xcd, 0xb3,
+	0xb2, 0xc3, 0xa9, 0x93, 0xb0, 0x37, 0x7b, 0x8c, 0x13, 0x37, 0xc5, 0x19, 0x31, 0x57, 0x73, 0x7b,
+	0xc5, 0x85, 0x83, 0x33, 0x62, 0x5d, 0x56, 0xe5, 0x73, 0x97, 0x90, 0xfb, 0x38, 0x3d, 0x24, 0x99,
+	0x6e, 0x49, 0x3f, 0x85, 0x8d, 0x59, 0x82, 0xca, 0xfa, 0x0f, 0x00, 0xfa, 0x84, 0xb8, 0xb1, 0xbc,
+	0x55, 0x85, 0x75, 0xa5, 0x98, 0x90, 0x31, 0xa4, 0xd0, 0x90, 0x6a, 0x7d, 0x7d, 0x69, 0xfd, 0xa7,
+	0x0c, 0x6f, 0x4c, 0xb1, 0x88, 0xe6, 0x4c, 0x28, 0xee, 0x45, 0xc4, 0x97, 0xe2, 0xaa, 0x8e, 0x3e,
+	0x0a, 0xdb, 0x85, 0xae, 0x3c, 0xd6, 0x79, 0xf0, 0xaa, 0x7d, 0x42, 0xf2, 0x58, 0xff, 0x04, 0xd6,
+	0x45, 0x12, 0xdd, 0x00, 0x8b, 0x57, 0x34, 0xf4, 0x88, 0x0c, 0x5a, 0xad, 0xdb, 0x11, 0x1a, 0xff,
+	0xf1, 0x62, 0x6b, 0x33, 0xcf, 0x34, 0xf7, 0x0f, 0xed, 0x90, 0xb5, 0x63, 0x9c, 0x0d, 0xec, 0x1f,
+	0x93, 0x00, 0x7b, 0x4f, 0xf6, 0x88, 0xf7, 0xfc, 0xd9, 0x0e, 0xe4, 0x64, 0x7b, 0x8f, 0x78, 0xce,
+	0x39, 0x21, 0xe8, 0x43, 0xcc, 0x0f, 0x84, 0x18, 0xf4, 0x19, 0xa0, 0x38, 0xa4, 0xee, 0x8c, 0xf0,
+	0xf2, 0x69, 0x85, 0x9f, 0x8f, 0x43, 0xda, 0x2d, 0xca, 0x7f, 0x04, 0x6f, 0x44, 0x04, 0xa7, 0x34,
+	0xa4, 0x41, 0x9e, 0x95, 0xb3, 0xa7, 0xb6, 0x5b, 0xcb, 0x11, 0xc9, 0x44, 0xef, 0xc2, 0x05, 0xf9,
+	0xaa, 0xb9, 0xc3, 0x2c, 0x8c, 0xc2, 0xa7, 0x79, 0xd9, 0x8b, 0x8c, 0x97, 0x9d, 0x37, 0x25, 0xe1,
+	0xe1, 0xe4, 0x1e, 0xbd, 0x07, 0x66, 0x86, 0xd3, 0x80, 0x64, 0xee, 0x3c, 0xa6, 0x22, 0x31, 0x1b,
+	0x39, 0xbd, 0x3b, 0x8b, 0xdc, 0x85, 0x4b, 0x31, 0xfe, 0x7c, 0x01, 0xac, 0x2a, 0x61, 0xdf, 0x89,
+	0xf1, 0xe7, 0x73, 0x98, 0xc7, 0xb0, 0x2e, 0x42, 0x3a, 0x8e, 0x26, 0x37, 0x6b, 0xcd, 0x95, 0xe2,
+	0xeb, 0x33, 0xf5, 0xd6, 0xee, 0x11, 0x4f, 0x3e, 0xb7, 0xb7, 0xd4, 0x73, 0xfb, 0xee, 0x37, 0x78,
+	0x6e, 0x15, 0x86, 0x3b, 0xe7, 0xe2, 0x90, 0xea, 0x50, 0x73, 0xeb, 0x63, 0x35, 0xd8, 0xa8, 0xb1,
+	0xe3, 0x80, 0xf1, 0x50, 0x18, 0xa4, 0xbb, 0x64, 0x61, 0xda, 0x30, 0xbe, 0xe1, 0xb4, 0x61, 0xfd,
+	0x1c, 0xae, 0x2e, 0x16, 0xa9, 0x3e, 0x90, 0xef, 0x43, 0x35, 0x51, 0x77, 0xea, 0xf3, 0xd8, 0x9c,
+	0x7a, 0x2e, 0xa7, 0x61, 0x7a, 0x54, 0xd2, 0x10, 0xeb, 0x0f, 0x2b, 0x70, 0x7e, 0x86, 0xe7, 0x34,
+	0x66, 0x4e, 0x0d, 0x36, 0xa5, 0xd7, 0x38, 0xd8, 0xa0, 0xbb, 0xb0, 0xe6, 0x93, 0x88, 0x04, 0xf9,
+	0x3c, 0xaa, 0x5a, 0x74, 0xa3, 0xe8, 0xf2, 0xde, 0x98, 0x3c, 0xe3, 0x75, 0x11, 0x88, 0xee, 0x00,
+	0x0c, 0x69, 0x8f, 0x51, 0x3f, 0xa4, 0x81, 0x68, 0x6e, 0x73, 0x63, 0xe6, 0x43, 0x4d, 0x9d, 0x91,
+	0x52, 0x80, 0xa1, 0x43, 0xa8, 0xa4, 0xe4, 0x31, 0x4e, 0x7d, 0x3d, 0xcd, 0xbd, 0x86, 0x0a, 0xd3,
+	0x1a, 0xac, 0x7f, 0x1b, 0x80, 0xe6, 0x7d, 0x43, 0x1f, 0xc1, 0x85, 0x11, 0x8e, 0x42, 0x1f, 0x67,
+	0x2c, 0x75, 0xa7, 0xf3, 0xf6, 0xd6, 0xf3, 0x67, 0x3b, 0xd7, 0x94, 0x41, 0x8f, 0x34, 0xcf, 0x74,
+	0x02, 0xdf, 0x1c, 0xcd, 0xdc, 0xa3, 0x7d, 0x58, 0xe5, 0x03, 0x9c, 0x4f, 0x13, 0xa7, 0x6c, 0x14,
+	0x4a, 0x00, 0x7a, 0x1f, 0x2a, 0x2a, 0x6f, 0xb2, 0x59, 0x2e, 0xad, 0x09, 0x35, 0xc8, 0x29, 0x7e,
+	0xeb, 0xbf, 0x06, 0x5c, 0x98, 0xcb, 0xc0, 0xff, 0xdd, 0xd7, 0x82, 0x81, 0xa5, 0x6f, 0x67, 0x20,
+	0xba, 0x0f, 0xe7, 0x3d, 0x16, 0x27, 0x11, 0x11, 0x86, 0xb9, 0x62, 0xff, 0x51, 0x3e, 0xd6, 0xed,
+	0x7c, 0x39, 0xb2, 0xf5, 0x72, 0x64, 0x3f, 0xd0, 0xcb, 0x51, 0xb7, 0x2a, 0x64, 0x7c, 0xf1, 0xf5,
+	0x96, 0xe1, 0xac, 0x4f, 0xc0, 0x82, 0x6c, 0xdd, 0x80, 0x6d, 0xf9, 0x99, 0xdf, 0x11, 0x1f, 0x35,
+	0xe5, 0x43, 0x7e, 0x80, 0x53, 0x1c, 0xf3, 0xfb, 0x61, 0x90, 0xe2, 0x42, 0x0b, 0xb1, 0x8e, 0xe0,
+	0xed, 0x13, 0xf8, 0x54, 0x5f, 0xb8, 0x07, 0xb5, 0x58, 0x5f, 0xaa, 0xc6, 0xb0, 0x5d, 0x2c, 0xef,
+	0xe3, 0x04, 0xe8, 0x27, 0x74, 0x0c, 0xb6, 0x0e, 0xc0, 0x3c, 0x8e, 0x59, 0x3c, 0xa6, 0xde, 0x80,
+	0xc8, 0x4d, 0x47, 0x3d, 0xa6, 0xea, 0x88, 0xea, 0x50, 0xcd, 0x45, 0xc8, 0x31, 0x5e, 0x90, 0xc6,
+	0xe7, 0xdd, 0x3f, 0x57, 0xe0, 0xac, 0xf4, 0x02, 0xfd, 0xd6, 0x80, 0xf5, 0xe9, 0x2d, 0x10, 0xdd,
+	0x28, 0x5a, 0x79, 0xfc, 0x12, 0x59, 0x7f, 0xe7, 0x44, 0xbe, 0x3c, 0x12, 0xd6, 0xf5, 0xdf, 0xfc,
+	0xf5, 0x5f, 0xbf, 0x2b, 0x5d, 0x43, 0x9b, 0xed, 0xc2, 0x6a, 0xae, 0xb6, 0x04, 0xbd, 0x32, 0xa2,
+	0xa7, 0xd3, 0x5b, 0xcb, 0xf5, 0x39, 0xe1, 0xf3, 0x8b, 0x54, 0x7d, 0x7b, 0x39, 0x93, 0x52, 0xdf,
+	0x94, 0xea, 0xeb, 0xc8, 0x2c, 0xaa, 0x2f, 0x2e, 0x59, 0x42, 0x77, 0x61, 0xdc, 0x5f, 0xa0, 0x7b,
+	0x7e, 0x7f, 0xa8, 0x6f, 0x2f, 0x67, 0x5a, 0xa6, 0x5b, 0x2e, 0x00, 0xee, 0x20, 0x57, 0x96, 0x42,
+	0x6d, 0x3c, 0x6a, 0xa3, 0xb7, 0xe6, 0x84, 0xce, 0x0e, 0xfa, 0x75, 0x6b, 0x19, 0x8b, 0xd2, 0xda,
+	0x90, 0x5a, 0x4d, 0xb4, 0x51, 0xd4, 0x3a, 0x19, 0xab, 0x85, 0xce, 0xf1, 0x48, 0xb6, 0x40, 0xe7,
+	0xec, 0x74, 0x58, 0xb7, 0x96, 0xb1, 0x2c, 0xd3, 0x39, 0x99, 0x1c, 0xd1, 0xef, 0x8d, 0xf9, 0x77,
+	0x6e, 0xbe, 0x82, 0x16, 0xbf, 0xdb, 0xf5, 0xd6, 0xc9, 0x8c, 0xca, 0x0c, 0x5b, 0x9a, 0xd1, 0x42,
+	0x37, 0x8a, 0x66, 0xa8, 0x22, 0x73, 0xf5, 0xa3, 0xdb, 0xfe, 0x85, 0x6a, 0x5d, 0xbf, 0x44, 0x7f,
+	0x32, 0x96, 0x7c, 0x5c, 0x37, 0xe7, 0xd4, 0x9e, 0xd0, 0x1d, 0xea, 0x9d, 0x6f, 0x81, 0x58, 0x66,
+	0xb1, 0xa7, 0x51, 0x6e, 0x22, 0x61, 0xee, 0xb8, 0x1b, 0x74, 0xdf, 0xfb, 0xf2, 0x65, 0xc3, 0xf8,
+	0xea, 0x65, 0xc3, 0xf8, 0xe7, 0xcb, 0x86, 0xf1, 0xc5, 0xab, 0xc6, 0x99, 0xaf, 0x5e, 0x35, 0xce,
+	0xfc, 0xfd, 0x55, 0xe3, 0xcc, 0xa7, 0x8d, 0xc2, 0xab, 0x46, 0x46, 0x64, 0x87, 0x92, 0xec, 0x31,
+	0x4b, 0x0f, 0xb5, 0xdc, 0xde, 0xaa, 0x6c, 0x88, 0xb7, 0xfe, 0x37, 0x00, 0x3b, 0x3c, 0xea, 0x13,
+	0x22, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleAccounts returns the module accounts of the app sorted by name, with
+	// their permissions, whether they are blocked from receiving funds and their
+	// balances.
+	ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error)
+	// UpgradeInfo returns the scheduled upgrade plan if any, the upgrades
+	// applied by the chain and the current module versions.
+	UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error)
+	// StoreHashes returns the commit hash of each store at a committed height,
+	// sorted by store name. Comparing them across nodes points at the stores
+	// that diverged.
+	StoreHashes(ctx context.Context, in *QueryStoreHashesRequest, opts ...grpc.CallOption) (*QueryStoreHashesResponse, error)
+	// HostZones returns a page of the fee abstraction host zones ordered by IBC
+	// denom, along with the feeabs channels shared by all host zones.
+	HostZones(ctx context.Context, in *QueryHostZonesRequest, opts ...grpc.CallOption) (*QueryHostZonesResponse, error)
+	// FeeMarket returns the fee market params and state, along with the minimum
+	// gas prices in every accepted fee denom.
+	FeeMarket(ctx context.Context, in *QueryFeeMarketRequest, opts ...grpc.CallOption) (*QueryFeeMarketResponse, error)
+	// AccountPosition returns the liquid balances, the delegations, the
+	// unbonding delegations and the pending staking rewards of an account.
+	// Unknown accounts get an empty position.
+	AccountPosition(ctx context.Context, in *QueryAccountPositionRequest, opts ...grpc.CallOption) (*QueryAccountPositionResponse, error)
+	// ConsensusParamsMigration returns the outcome of the consensus params
+	// migration run by the node with the first block it finalized since it
+	// started. It is local to the node.
+	ConsensusParamsMigration(ctx context.Context, in *QueryConsensusParamsMigrationRequest, opts ...grpc.CallOption) (*QueryConsensusParamsMigrationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleAccounts(ctx context.Context, in *QueryModuleAccountsRequest, opts ...grpc.CallOption) (*QueryModuleAccountsResponse, error) {
+	out := new(QueryModuleAccountsResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/ModuleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error) {
+	out := new(QueryUpgradeInfoResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/UpgradeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoreHashes(ctx context.Context, in *QueryStoreHashesRequest, opts ...grpc.CallOption) (*QueryStoreHashesResponse, error) {
+	out := new(QueryStoreHashesResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/StoreHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostZones(ctx context.Context, in *QueryHostZonesRequest, opts ...grpc.CallOption) (*QueryHostZonesResponse, error) {
+	out := new(QueryHostZonesResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/HostZones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeMarket(ctx context.Context, in *QueryFeeMarketRequest, opts ...grpc.CallOption) (*QueryFeeMarketResponse, error) {
+	out := new(QueryFeeMarketResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/FeeMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountPosition(ctx context.Context, in *QueryAccountPositionRequest, opts ...grpc.CallOption) (*QueryAccountPositionResponse, error) {
+	out := new(QueryAccountPositionResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/AccountPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusParamsMigration(ctx context.Context, in *QueryConsensusParamsMigrationRequest, opts ...grpc.CallOption) (*QueryConsensusParamsMigrationResponse, error) {
+	out := new(QueryConsensusParamsMigrationResponse)
+	err := c.cc.Invoke(ctx, "/eve.app.v1.Query/ConsensusParamsMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ModuleAccounts returns the module accounts of the app sorted by name, with
+	// their permissions, whether they are blocked from receiving funds and their
+	// balances.
+	ModuleAccounts(context.Context, *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error)
+	// UpgradeInfo returns the scheduled upgrade plan if any, the upgrades
+	// applied by the chain and the current module versions.
+	UpgradeInfo(context.Context, *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error)
+	// StoreHashes returns the commit hash of each store at a committed height,
+	// sorted by store name. Comparing them across nodes points at the stores
+	// that diverged.
+	StoreHashes(context.Context, *QueryStoreHashesRequest) (*QueryStoreHashesResponse, error)
+	// HostZones returns a page of the fee abstraction host zones ordered by IBC
+	// denom, along with the feeabs channels shared by all host zones.
+	HostZones(context.Context, *QueryHostZonesRequest) (*QueryHostZonesResponse, error)
+	// FeeMarket returns the fee market params and state, along with the minimum
+	// gas prices in every accepted fee denom.
+	FeeMarket(context.Context, *QueryFeeMarketRequest) (*QueryFeeMarketResponse, error)
+	// AccountPosition returns the liquid balances, the delegations, the
+	// unbonding delegations and the pending staking rewards of an account.
+	// Unknown accounts get an empty position.
+	AccountPosition(context.Context, *QueryAccountPositionRequest) (*QueryAccountPositionResponse, error)
+	// ConsensusParamsMigration returns the outcome of the consensus params
+	// migration run by the node with the first block it finalized since it
+	// started. It is local to the node.
+	ConsensusParamsMigration(context.Context, *QueryConsensusParamsMigrationRequest) (*QueryConsensusParamsMigrationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ModuleAccounts(ctx context.Context, req *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccounts not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeInfo(ctx context.Context, req *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeInfo not implemented")
+}
+func (*UnimplementedQueryServer) StoreHashes(ctx context.Context, req *QueryStoreHashesRequest) (*QueryStoreHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreHashes not implemented")
+}
+func (*UnimplementedQueryServer) HostZones(ctx context.Context, req *QueryHostZonesRequest) (*QueryHostZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZones not implemented")
+}
+func (*UnimplementedQueryServer) FeeMarket(ctx context.Context, req *QueryFeeMarketRequest) (*QueryFeeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMarket not implemented")
+}
+func (*UnimplementedQueryServer) AccountPosition(ctx context.Context, req *QueryAccountPositionRequest) (*QueryAccountPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPosition not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusParamsMigration(ctx context.Context, req *QueryConsensusParamsMigrationRequest) (*QueryConsensusParamsMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusParamsMigration not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ModuleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/ModuleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccounts(ctx, req.(*QueryModuleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/UpgradeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeInfo(ctx, req.(*QueryUpgradeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoreHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoreHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoreHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/StoreHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoreHashes(ctx, req.(*QueryStoreHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/HostZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZones(ctx, req.(*QueryHostZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/FeeMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeMarket(ctx, req.(*QueryFeeMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/AccountPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountPosition(ctx, req.(*QueryAccountPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusParamsMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusParamsMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusParamsMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.app.v1.Query/ConsensusParamsMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusParamsMigration(ctx, req.(*QueryConsensusParamsMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eve.app.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleAccounts",
+			Handler:    _Query_ModuleAccounts_Handler,
+		},
+		{
+			MethodName: "UpgradeInfo",
+			Handler:    _Query_UpgradeInfo_Handler,
+		},
+		{
+			MethodName: "StoreHashes",
+			Handler:    _Query_StoreHashes_Handler,
+		},
+		{
+			MethodName: "HostZones",
+			Handler:    _Query_HostZones_Handler,
+		},
+		{
+			MethodName: "FeeMarket",
+			Handler:    _Query_FeeMarket_Handler,
+		},
+		{
+			MethodName: "AccountPosition",
+			Handler:    _Query_AccountPosition_Handler,
+		},
+		{
+			MethodName: "ConsensusParamsMigration",
+			Handler:    _Query_ConsensusParamsMigration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eve/app/v1/query.proto",
+}
+
+func (m *QueryModuleAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpgradeInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Applied) > 0 {
+		for iNdEx := len(m.Applied) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applied[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AppliedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppliedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppliedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoreHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoreHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoreHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HostZones) > 0 {
+		for iNdEx := len(m.HostZones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IbcQueryIcqChannel) > 0 {
+		i -= len(m.IbcQueryIcqChannel)
+		copy(dAtA[i:], m.IbcQueryIcqChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcQueryIcqChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IbcTransferChannel) > 0 {
+		i -= len(m.IbcTransferChannel)
+		copy(dAtA[i:], m.IbcTransferChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcTransferChannel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TwapRate) > 0 {
+		i -= len(m.TwapRate)
+		copy(dAtA[i:], m.TwapRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TwapRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OsmosisPoolTokenDenomIn) > 0 {
+		i -= len(m.OsmosisPoolTokenDenomIn)
+		copy(dAtA[i:], m.OsmosisPoolTokenDenomIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OsmosisPoolTokenDenomIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxBlockUtilization != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlockUtilization))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TargetBlockUtilization != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetBlockUtilization))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockUtilization != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockUtilization))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusParamsMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusParamsMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusParamsMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusParamsMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusParamsMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusParamsMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusParamsMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusParamsMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusParamsMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Migrated {
+		i--
+		if m.Migrated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Checked {
+		i--
+		if m.Checked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocked {
+		n += 2
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpgradeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UpgradeInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *UpgradeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Applied) > 0 {
+		for _, e := range m.Applied {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppliedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryStoreHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryStoreHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcTransferChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IbcQueryIcqChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.HostZones) > 0 {
+		for _, e := range m.HostZones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *HostZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OsmosisPoolTokenDenomIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	l = len(m.TwapRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeMarket.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FeeMarketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockUtilization != 0 {
+		n += 1 + sovQuery(uint64(m.BlockUtilization))
+	}
+	if m.TargetBlockUtilization != 0 {
+		n += 1 + sovQuery(uint64(m.TargetBlockUtilization))
+	}
+	if m.MaxBlockUtilization != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlockUtilization))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AccountPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *UnbondingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsensusParamsMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsensusParamsMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Migration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ConsensusParamsMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checked {
+		n += 2
+	}
+	if m.Migrated {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryModuleAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ModuleAccountInfo{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &types1.Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, AppliedUpgrade{})
+			if err := m.Applied[len(m.Applied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &types1.ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppliedUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoreHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoreHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoreHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoreHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, StoreHash{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransferChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcTransferChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcQueryIcqChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcQueryIcqChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZones = append(m.HostZones, HostZone{})
+			if err := m.HostZones[len(m.HostZones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmosisPoolTokenDenomIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmosisPoolTokenDenomIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockUtilization", wireType)
+			}
+			m.BlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			m.TargetBlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockUtilization", wireType)
+			}
+			m.MaxBlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegationPosition{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingPosition{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusParamsMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusParamsMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusParamsMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusParamsMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusParamsMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusParamsMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusParamsMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusParamsMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusParamsMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Checked = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Migrated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
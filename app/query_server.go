@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryGasLimit caps the gas of the queries of the app's Query service, below
+// the query-gas-limit of the node when it is unset or higher. Unlike most
+// module queries, these read from several stores at once.
+const QueryGasLimit = 10_000_000
+
+// MaxPageLimit is the largest page returned by the paginated queries of the
+// app's Query service.
+const MaxPageLimit = query.DefaultLimit
+
+var _ QueryServer = queryServer{}
+
+// queryServer implements the app's Query service.
+type queryServer struct {
+	app *EveApp
+}
+
+func (q queryServer) ModuleAccounts(goCtx context.Context, _ *QueryModuleAccountsRequest) (*QueryModuleAccountsResponse, error) {
+	return runQuery(goCtx, func(ctx sdk.Context) (*QueryModuleAccountsResponse, error) {
+		return &QueryModuleAccountsResponse{Accounts: q.app.ModuleAccounts(ctx)}, nil
+	})
+}
+
+func (q queryServer) UpgradeInfo(goCtx context.Context, _ *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error) {
+	return runQuery(goCtx, func(ctx sdk.Context) (*QueryUpgradeInfoResponse, error) {
+		info, err := q.app.UpgradeInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &QueryUpgradeInfoResponse{UpgradeInfo: info}, nil
+	})
+}
+
+// StoreHashes reads the hashes from the commit info of the node rather than
+// from a store, so it doesn't consume gas.
+func (q queryServer) StoreHashes(_ context.Context, req *QueryStoreHashesRequest) (*QueryStoreHashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = q.app.LastBlockHeight()
+	}
+	hashes, err := q.app.StoreHashes(height)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &QueryStoreHashesResponse{Height: height, Stores: hashes}, nil
+}
+
+func (q queryServer) HostZones(goCtx context.Context, req *QueryHostZonesRequest) (*QueryHostZonesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return runQuery(goCtx, func(ctx sdk.Context) (*QueryHostZonesResponse, error) {
+		hostZones, pageRes, err := q.app.HostZones(ctx, limitPageRequest(req.Pagination))
+		if err != nil {
+			return nil, err
+		}
+		params := q.app.FeeabsKeeper.GetParams(ctx)
+		return &QueryHostZonesResponse{
+			IbcTransferChannel: params.IbcTransferChannel,
+			IbcQueryIcqChannel: params.IbcQueryIcqChannel,
+			HostZones:          hostZones,
+			Pagination:         pageRes,
+		}, nil
+	})
+}
+
+func (q queryServer) FeeMarket(goCtx context.Context, _ *QueryFeeMarketRequest) (*QueryFeeMarketResponse, error) {
+	return runQuery(goCtx, func(ctx sdk.Context) (*QueryFeeMarketResponse, error) {
+		info, err := q.app.FeeMarketInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &QueryFeeMarketResponse{FeeMarket: info}, nil
+	})
+}
+
+func (q queryServer) AccountPosition(goCtx context.Context, req *QueryAccountPositionRequest) (*QueryAccountPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return runQuery(goCtx, func(ctx sdk.Context) (*QueryAccountPositionResponse, error) {
+		position, err := q.app.AccountPosition(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		return &QueryAccountPositionResponse{Position: position}, nil
+	})
+}
+
+// ConsensusParamsMigration reads the outcome recorded by the node rather than
+// a store, so it doesn't consume gas.
+func (q queryServer) ConsensusParamsMigration(_ context.Context, req *QueryConsensusParamsMigrationRequest) (*QueryConsensusParamsMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	return &QueryConsensusParamsMigrationResponse{Migration: q.app.ConsensusParamsMigration()}, nil
+}
+
+// limitPageRequest returns the page request with its limit capped to
+// MaxPageLimit. Without a limit, pages hold query.DefaultLimit items, which is
+// the same.
+func limitPageRequest(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil || pageReq.Limit <= MaxPageLimit {
+		return pageReq
+	}
+	limited := *pageReq
+	limited.Limit = MaxPageLimit
+	return &limited
+}
+
+// runQuery runs query with its gas limited to QueryGasLimit, returning an
+// ErrOutOfGas error if it runs out of gas.
+func runQuery[T any](goCtx context.Context, query func(ctx sdk.Context) (T, error)) (res T, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	limit := min(ctx.GasMeter().GasRemaining(), QueryGasLimit)
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(limit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "query out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, limit)
+		}
+	}()
+	return query(ctx)
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// queryApp runs a query of the app's Query service through ABCI, at the
+// latest committed height.
+func queryApp(t *testing.T, app *EveApp, method string, req, res proto.Message) {
+	t.Helper()
+	bz, err := proto.Marshal(req)
+	require.NoError(t, err)
+	resp, err := app.Query(context.Background(), &abci.RequestQuery{Path: "/eve.app.v1.Query/" + method, Data: bz})
+	require.NoError(t, err)
+	require.Zero(t, resp.Code, resp.Log)
+	require.NoError(t, proto.Unmarshal(resp.Value, res))
+}
+
+func TestRunQueryGasLimit(t *testing.T) {
+	consume := func(gas uint64) func(ctx sdk.Context) (struct{}, error) {
+		return func(ctx sdk.Context) (struct{}, error) {
+			ctx.GasMeter().ConsumeGas(gas, "test")
+			return struct{}{}, nil
+		}
+	}
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	_, err := runQuery(ctx, consume(QueryGasLimit))
+	require.NoError(t, err)
+	_, err = runQuery(ctx, consume(QueryGasLimit+1))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+
+	// a lower query-gas-limit of the node still applies
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+	_, err = runQuery(ctx, consume(1001))
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+}
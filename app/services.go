@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+)
+
+// ListServices returns the full names of the gRPC methods routed by the app,
+// sorted: the methods of the Msg services registered with the
+// MsgServiceRouter and the ones of the Query services registered with the
+// GRPCQueryRouter. Services whose registration was skipped are missing from it.
+func (app *EveApp) ListServices() []string {
+	var methods []string
+	proto.HybridResolver.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			isMsgService := protov2.HasExtension(service.Options(), msgv1.E_Service)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				fullName := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+				switch {
+				case isMsgService && app.MsgServiceRouter().HandlerByTypeURL("/"+string(method.Input().FullName())) != nil:
+					methods = append(methods, fullName)
+				case !isMsgService && app.GRPCQueryRouter().Route(fullName) != nil:
+					methods = append(methods, fullName)
+				}
+			}
+		}
+		return true
+	})
+	sort.Strings(methods)
+	return methods
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListServices(t *testing.T) {
+	app := Setup(t)
+
+	services := app.ListServices()
+	require.True(t, sort.StringsAreSorted(services))
+	for _, method := range []string{
+		"/cosmos.bank.v1beta1.Msg/Send",
+		"/cosmos.bank.v1beta1.Query/Balance",
+		"/cosmos.staking.v1beta1.Msg/Delegate",
+		"/cosmos.gov.v1.Msg/SubmitProposal",
+		"/cosmos.upgrade.v1beta1.Query/ModuleVersions",
+		"/cosmwasm.wasm.v1.Msg/ExecuteContract",
+		"/cosmwasm.wasm.v1.Query/SmartContractState",
+		"/ibc.applications.transfer.v1.Msg/Transfer",
+		"/tokenfactory.v1beta1.Msg/CreateDenom",
+		"/feeabstraction.feeabs.v1beta1.Query/HostChainConfig",
+		"/feemarket.feemarket.v1.Query/GasPrice",
+		"/eve.app.v1.Query/ModuleAccounts",
+		"/eve.app.v1.Query/UpgradeInfo",
+		"/eve.app.v1.Query/StoreHashes",
+		"/eve.app.v1.Query/HostZones",
+		"/eve.app.v1.Query/FeeMarket",
+		"/eve.app.v1.Query/AccountPosition",
+		"/eve.app.v1.Query/ConsensusParamsMigration",
+	} {
+		require.Contains(t, services, method)
+	}
+	// the services of the node, served next to the routers, aren't listed
+	require.NotContains(t, services, "/cosmos.tx.v1beta1.Service/Simulate")
+}
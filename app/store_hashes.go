@@ -0,0 +1,44 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// commitInfoStore is implemented by the root multistore.
+type commitInfoStore interface {
+	GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+}
+
+// StoreHashes returns the commit hash of each store at the given height, or
+// at the latest committed height when height is 0, sorted by store name. The
+// hashes are read from the committed info so comparing them across nodes
+// points at the stores that diverged.
+func (app *EveApp) StoreHashes(height int64) ([]StoreHash, error) {
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("invalid height %d", height)
+	}
+
+	cms, ok := app.CommitMultiStore().(commitInfoStore)
+	if !ok {
+		return nil, fmt.Errorf("commit multistore %T does not expose commit info", app.CommitMultiStore())
+	}
+	info, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]StoreHash, 0, len(info.StoreInfos))
+	for _, storeInfo := range info.StoreInfos {
+		hashes = append(hashes, StoreHash{Name: storeInfo.Name, Hash: storeInfo.CommitId.Hash})
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return hashes[i].Name < hashes[j].Name
+	})
+	return hashes, nil
+}
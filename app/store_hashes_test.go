@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestStoreHashes(t *testing.T) {
+	eveApp, other := SetupWithEmptyStore(t), SetupWithEmptyStore(t)
+	stateBytes, err := json.Marshal(GenesisStateWithSingleValidator(t, eveApp))
+	require.NoError(t, err)
+
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextBlock := func(app *EveApp, mutate bool) {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: app.LastBlockHeight() + 1,
+			Time:   genesisTime.Add(time.Duration(app.LastBlockHeight()) * time.Second),
+		})
+		require.NoError(t, err)
+		if mutate {
+			app.CommitMultiStore().GetKVStore(app.GetKey(banktypes.StoreKey)).Set([]byte("divergence"), []byte{1})
+		}
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+	for _, app := range []*EveApp{eveApp, other} {
+		_, err := app.InitChain(&abci.RequestInitChain{
+			ChainId:         "testing",
+			Time:            genesisTime,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+			InitialHeight:   1,
+		})
+		require.NoError(t, err)
+		nextBlock(app, false)
+	}
+
+	hashes, err := eveApp.StoreHashes(0)
+	require.NoError(t, err)
+	require.Len(t, hashes, len(eveApp.GetStoreKeys()))
+	for i, key := range eveApp.GetStoreKeys() {
+		require.Equal(t, key.Name(), hashes[i].Name)
+	}
+	otherHashes, err := other.StoreHashes(0)
+	require.NoError(t, err)
+	require.Equal(t, hashes, otherHashes)
+
+	// only the mutated store diverges
+	nextBlock(eveApp, false)
+	nextBlock(other, true)
+	hashes, err = eveApp.StoreHashes(2)
+	require.NoError(t, err)
+	otherHashes, err = other.StoreHashes(2)
+	require.NoError(t, err)
+	for i := range hashes {
+		if hashes[i].Name == banktypes.StoreKey {
+			require.NotEqual(t, hashes[i].Hash, otherHashes[i].Hash)
+			continue
+		}
+		require.Equal(t, hashes[i], otherHashes[i], hashes[i].Name)
+	}
+
+	// past heights stay available
+	previous, err := eveApp.StoreHashes(1)
+	require.NoError(t, err)
+	otherPrevious, err := other.StoreHashes(1)
+	require.NoError(t, err)
+	require.Equal(t, previous, otherPrevious)
+
+	_, err = eveApp.StoreHashes(10)
+	require.Error(t, err)
+}
+
+func TestStoreHashesQuery(t *testing.T) {
+	app := Setup(t)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	var res QueryStoreHashesResponse
+	queryApp(t, app, "StoreHashes", &QueryStoreHashesRequest{}, &res)
+	require.Equal(t, app.LastBlockHeight(), res.Height)
+	expected, err := app.StoreHashes(0)
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Stores)
+
+	_, err = queryServer{app: app}.StoreHashes(context.Background(), &QueryStoreHashesRequest{Height: app.LastBlockHeight() + 10})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
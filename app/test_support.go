@@ -5,6 +5,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -24,6 +25,10 @@ func (app *EveApp) GetBaseApp() *baseapp.BaseApp {
 	return app.BaseApp
 }
 
+func (app *EveApp) GetTxConfig() client.TxConfig {
+	return app.TxConfig()
+}
+
 func (app *EveApp) GetBankKeeper() bankkeeper.Keeper {
 	return app.BankKeeper
 }
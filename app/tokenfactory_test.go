@@ -0,0 +1,57 @@
+package app
+
+import (
+	"testing"
+
+	tokenfactorytypes "github.com/osmosis-labs/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTokenFactoryAdminLifecycle(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addrs := AddTestAddrsIncremental(app, ctx, 3, sdkmath.NewInt(100_000_000_000))
+	creator, newAdmin, holder := addrs[0].String(), addrs[1].String(), addrs[2].String()
+
+	deliver := func(msg sdk.Msg) error {
+		handler := app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler, "no handler for %s", sdk.MsgTypeURL(msg))
+		_, err := handler(ctx, msg)
+		return err
+	}
+
+	require.NoError(t, deliver(tokenfactorytypes.NewMsgCreateDenom(creator, "eve")))
+	denom, err := tokenfactorytypes.GetTokenDenom(creator, "eve")
+	require.NoError(t, err)
+	require.NoError(t, deliver(tokenfactorytypes.NewMsgMint(creator, sdk.NewInt64Coin(denom, 1000))))
+
+	require.NoError(t, deliver(tokenfactorytypes.NewMsgChangeAdmin(creator, denom, newAdmin)))
+	authority, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin, authority.Admin)
+
+	// the previous admin lost its rights
+	require.ErrorIs(t, deliver(tokenfactorytypes.NewMsgForceTransfer(creator, sdk.NewInt64Coin(denom, 100), creator, holder)), tokenfactorytypes.ErrUnauthorized)
+
+	require.NoError(t, deliver(tokenfactorytypes.NewMsgForceTransfer(newAdmin, sdk.NewInt64Coin(denom, 400), creator, holder)))
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, addrs[0], denom).Amount)
+	require.Equal(t, sdkmath.NewInt(400), app.BankKeeper.GetBalance(ctx, addrs[2], denom).Amount)
+
+	metadata := banktypes.Metadata{
+		Description: "eve test token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        "eve",
+		Symbol:      "EVE",
+	}
+	require.NoError(t, deliver(tokenfactorytypes.NewMsgSetDenomMetadata(newAdmin, metadata)))
+	got, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, metadata, got)
+}
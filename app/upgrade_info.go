@@ -0,0 +1,54 @@
+package app
+
+import (
+	"encoding/binary"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeInfo returns the scheduled upgrade plan if any, the applied upgrades
+// ordered by height and the current module versions.
+func (app *EveApp) UpgradeInfo(ctx sdk.Context) (UpgradeInfo, error) {
+	var info UpgradeInfo
+
+	plan, err := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	switch {
+	case err == nil:
+		info.Plan = &plan
+	case !errors.Is(err, upgradetypes.ErrNoUpgradePlanFound):
+		return UpgradeInfo{}, err
+	}
+
+	// done keys are the DoneByte, the big endian height and the upgrade name,
+	// see x/upgrade's keeper
+	store := ctx.KVStore(app.GetKey(upgradetypes.StoreKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{upgradetypes.DoneByte})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) < 10 {
+			continue
+		}
+		info.Applied = append(info.Applied, AppliedUpgrade{
+			Name:   string(key[9:]),
+			Height: int64(binary.BigEndian.Uint64(key[1:9])),
+		})
+	}
+
+	info.ModuleVersions, err = app.ModuleVersions(ctx)
+	if err != nil {
+		return UpgradeInfo{}, err
+	}
+	return info, nil
+}
+
+// ModuleVersions returns the consensus version of every module, sorted by
+// name, as stored by x/upgrade after the migrations of the applied upgrades.
+// It is what `eved query upgrade module-versions` prints.
+func (app *EveApp) ModuleVersions(ctx sdk.Context) ([]*upgradetypes.ModuleVersion, error) {
+	return app.UpgradeKeeper.GetModuleVersions(ctx)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/eve-network/eve/app/upgrades"
 	v1 "github.com/eve-network/eve/app/upgrades/v1"
+	v2 "github.com/eve-network/eve/app/upgrades/v2"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -21,12 +22,12 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	v2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
+	wasmv2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 // Upgrades list of chain upgrades
-var Upgrades = []upgrades.Upgrade{v1.Upgrade}
+var Upgrades = []upgrades.Upgrade{v1.Upgrade, v2.Upgrade}
 
 // RegisterUpgradeHandlers registers the chain upgrade handlers
 func (app *EveApp) RegisterUpgradeHandlers() {
@@ -97,7 +98,7 @@ func setupLegacyKeyTables(k *paramskeeper.Keeper) {
 			keyTable = crisistypes.ParamKeyTable() //nolint:staticcheck
 			// wasm
 		case wasmtypes.ModuleName:
-			keyTable = v2.ParamKeyTable() //nolint:staticcheck
+			keyTable = wasmv2.ParamKeyTable() //nolint:staticcheck
 		default:
 			continue
 		}
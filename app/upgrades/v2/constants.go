@@ -0,0 +1,33 @@
+package v2
+
+import (
+	"github.com/eve-network/eve/app/blocklist"
+	"github.com/eve-network/eve/app/feebypass"
+	"github.com/eve-network/eve/app/mingasprices"
+	"github.com/eve-network/eve/app/upgrades"
+	"github.com/eve-network/eve/app/wasmallowlist"
+
+	store "cosmossdk.io/store/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v0.2.0"
+)
+
+// Upgrade is the v0.2.0 upgrade. Besides its store and params migrations, fee
+// and authz grants to accounts which don't exist yet no longer fail, as the
+// fee grant and authz keepers are given the bank keeper they were missing.
+// Blocks past the upgrade height can't be replayed with earlier binaries.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			blocklist.StoreKey,
+			feebypass.StoreKey,
+			mingasprices.StoreKey,
+			wasmallowlist.StoreKey,
+		},
+	},
+}
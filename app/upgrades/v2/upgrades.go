@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/eve-network/eve/app/upgrades"
+
+	"cosmossdk.io/store/prefix"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// LegacySubspaces maps each legacy x/params subspace to the consensus version
+// of its module from which the params are kept in the module's own store.
+var LegacySubspaces = map[string]uint64{
+	authtypes.ModuleName:     4,
+	banktypes.ModuleName:     4,
+	stakingtypes.ModuleName:  4,
+	minttypes.ModuleName:     2,
+	distrtypes.ModuleName:    3,
+	slashingtypes.ModuleName: 3,
+	govtypes.ModuleName:      4,
+	crisistypes.ModuleName:   2,
+	wasmtypes.ModuleName:     3,
+}
+
+func CreateUpgradeHandler(mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	keepers *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		sdkCtx.Logger().Info("Starting module migrations...")
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		err = DeleteLegacySubspaces(sdkCtx, keepers, vm)
+		if err != nil {
+			return vm, err
+		}
+		return vm, nil
+	}
+}
+
+// DeleteLegacySubspaces removes the x/params entries of the modules that keep
+// typed params in their own store, and of the consensus params moved to
+// x/consensus. It fails without deleting anything if one of those modules has
+// not migrated its params yet. Deleting an already empty subspace is a no-op,
+// so running it more than once is safe.
+func DeleteLegacySubspaces(ctx sdk.Context, keepers *upgrades.AppKeepers, vm module.VersionMap) error {
+	for subspace, version := range LegacySubspaces {
+		if vm[subspace] < version {
+			return fmt.Errorf("module %s has not migrated its params: version %d, expected at least %d", subspace, vm[subspace], version)
+		}
+	}
+	if _, err := keepers.ConsensusParamsKeeper.ParamsStore.Get(ctx); err != nil {
+		return fmt.Errorf("consensus params have not been migrated: %w", err)
+	}
+
+	store := ctx.KVStore(keepers.GetStoreKey(paramstypes.StoreKey))
+	subspaces := append(slices.Sorted(maps.Keys(LegacySubspaces)), baseapp.Paramspace)
+	for _, subspace := range subspaces {
+		deletePrefix(prefix.NewStore(store, append([]byte(subspace), '/')))
+	}
+	return nil
+}
+
+func deletePrefix(store prefix.Store) {
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/eve-network/eve/app/upgrades"
+	v2 "github.com/eve-network/eve/app/upgrades/v2"
+	"github.com/stretchr/testify/require"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestDeleteLegacySubspaces(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	keepers := upgrades.AppKeepers{
+		ParamsKeeper:          &app.ParamsKeeper,
+		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
+		GetStoreKey:           app.GetKey,
+	}
+
+	mintParams, err := app.MintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	app.GetSubspace(minttypes.ModuleName).SetParamSet(ctx, &mintParams)
+
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	legacyKeys := func() int {
+		iterator := storetypes.KVStorePrefixIterator(paramsStore, []byte(minttypes.ModuleName+"/"))
+		defer iterator.Close()
+		count := 0
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		return count
+	}
+	require.NotZero(t, legacyKeys())
+
+	// modules that have not migrated their params yet abort the deletion
+	require.Error(t, v2.DeleteLegacySubspaces(ctx, &keepers, module.VersionMap{}))
+	require.NotZero(t, legacyKeys())
+
+	handler := v2.CreateUpgradeHandler(app.ModuleManager, app.Configurator(), &keepers)
+	_, err = handler(ctx, upgradetypes.Plan{Name: v2.UpgradeName}, app.ModuleManager.GetVersionMap())
+	require.NoError(t, err)
+	require.Zero(t, legacyKeys())
+
+	migratedParams, err := app.MintKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mintParams, migratedParams)
+	_, err = app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+}
+
+func TestUpgradeInfo(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	info, err := app.UpgradeInfo(ctx)
+	require.NoError(t, err)
+	require.Nil(t, info.Plan)
+	require.Empty(t, info.Applied)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), versionMap(info.ModuleVersions))
+
+	plan := upgradetypes.Plan{Name: "test", Height: ctx.BlockHeight() + 10}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+	info, err = app.UpgradeInfo(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, info.Plan)
+	require.Equal(t, plan.Name, info.Plan.Name)
+	require.Equal(t, plan.Height, info.Plan.Height)
+	require.Empty(t, info.Applied)
+
+	app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	upgradeCtx := ctx.WithHeaderInfo(header.Info{Height: plan.Height})
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(upgradeCtx, plan))
+
+	info, err = app.UpgradeInfo(ctx)
+	require.NoError(t, err)
+	require.Nil(t, info.Plan)
+	require.Equal(t, []AppliedUpgrade{{Name: plan.Name, Height: plan.Height}}, info.Applied)
+}
+
+func TestUpgradeInfoQuery(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	plan := upgradetypes.Plan{Name: "test", Height: ctx.BlockHeight() + 10}
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: app.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	var res QueryUpgradeInfoResponse
+	queryApp(t, app, "UpgradeInfo", &QueryUpgradeInfoRequest{}, &res)
+	require.NotNil(t, res.UpgradeInfo.Plan)
+	require.Equal(t, plan.Name, res.UpgradeInfo.Plan.Name)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), versionMap(res.UpgradeInfo.ModuleVersions))
+}
+
+// versionMap returns the version map of the module versions.
+func versionMap(versions []*upgradetypes.ModuleVersion) module.VersionMap {
+	vm := make(module.VersionMap, len(versions))
+	for _, version := range versions {
+		vm[version.Name] = version.Version
+	}
+	return vm
+}
+
+func TestModuleVersions(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	versions, err := app.ModuleVersions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), versionMap(versions))
+	require.True(t, slices.IsSortedFunc(versions, func(a, b *upgradetypes.ModuleVersion) int {
+		return strings.Compare(a.Name, b.Name)
+	}))
+
+	// the versions set by an upgrade's migrations are reported
+	plan := upgradetypes.Plan{Name: "test", Height: ctx.BlockHeight() + 1}
+	app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm[minttypes.ModuleName]++
+		return vm, nil
+	})
+	require.NoError(t, app.UpgradeKeeper.ApplyUpgrade(ctx.WithHeaderInfo(header.Info{Height: plan.Height}), plan))
+	versions, err = app.ModuleVersions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap()[minttypes.ModuleName]+1, versionMap(versions)[minttypes.ModuleName])
+
+	// served on the command line by the upgrade module's autocli
+	upgradeOpts := app.AutoCliOpts().ModuleOptions[upgradetypes.ModuleName]
+	require.NotNil(t, upgradeOpts)
+	require.True(t, slices.ContainsFunc(upgradeOpts.Query.RpcCommandOptions, func(opts *autocliv1.RpcCommandOptions) bool {
+		return opts.RpcMethod == "ModuleVersions" && strings.HasPrefix(opts.Use, "module-versions")
+	}))
+}
@@ -1,5 +1,17 @@
 package app
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
 // AllCapabilities returns all capabilities available with the current wasmvm
 // See https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 // This functionality is going to be moved upstream: https://github.com/CosmWasm/wasmvm/issues/425
@@ -15,3 +27,31 @@ func AllCapabilities() []string {
 		"cosmwasm_2_0",
 	}
 }
+
+// NewQueryGasCeilingDecorator returns a wasm query handler decorator capping
+// the gas of each contract query made while simulating or checking a tx, so a
+// single heavy query can't use up the whole simulation budget. Queries
+// exceeding the ceiling fail with an out of gas error. Block execution is left
+// untouched as the ceiling is a node local setting.
+func NewQueryGasCeilingDecorator(ceiling storetypes.Gas) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(next wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) (res []byte, err error) {
+			execMode := ctx.ExecMode()
+			if ceiling == 0 || request.Wasm == nil || (execMode != sdk.ExecModeSimulate && execMode != sdk.ExecModeCheck && execMode != sdk.ExecModeReCheck) {
+				return next.HandleQuery(ctx, caller, request)
+			}
+
+			queryCtx := ctx.WithGasMeter(storetypes.NewGasMeter(ceiling))
+			defer func() {
+				ctx.GasMeter().ConsumeGas(min(queryCtx.GasMeter().GasConsumed(), ceiling), "wasm query")
+				if r := recover(); r != nil {
+					if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !queryCtx.GasMeter().IsOutOfGas() {
+						panic(r)
+					}
+					res, err = nil, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "wasm query exceeded the gas ceiling of %d", ceiling)
+				}
+			}()
+			return next.HandleQuery(queryCtx, caller, request)
+		})
+	}
+}
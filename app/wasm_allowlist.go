@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+
+	"github.com/eve-network/eve/app/wasmallowlist"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/CosmWasm/wasmd/x/wasm/exported"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// wasmAppModule is the wasm module with its msg server wrapped by
+// wasmAllowlistMsgServer. Every wasm message goes through the msg server,
+// whether it comes from a tx, an authz grant, an interchain account or a
+// contract, so the allowlists can't be bypassed the way the ante handler can.
+type wasmAppModule struct {
+	wasm.AppModule
+	keeper         *wasmkeeper.Keeper
+	legacySubspace exported.Subspace
+	allowlist      wasmallowlist.Keeper
+}
+
+func newWasmAppModule(module wasm.AppModule, keeper *wasmkeeper.Keeper, legacySubspace exported.Subspace, allowlist wasmallowlist.Keeper) wasmAppModule {
+	return wasmAppModule{
+		AppModule:      module,
+		keeper:         keeper,
+		legacySubspace: legacySubspace,
+		allowlist:      allowlist,
+	}
+}
+
+// RegisterServices registers the services of the wasm module like it does,
+// with the wrapped msg server.
+func (am wasmAppModule) RegisterServices(cfg module.Configurator) {
+	wasmtypes.RegisterMsgServer(cfg.MsgServer(), wasmAllowlistMsgServer{
+		MsgServer: wasmkeeper.NewMsgServerImpl(am.keeper),
+		keeper:    am.keeper,
+		authority: am.keeper.GetAuthority(),
+		allowlist: am.allowlist,
+	})
+	wasmtypes.RegisterQueryServer(cfg.QueryServer(), wasmkeeper.Querier(am.keeper))
+
+	m := wasmkeeper.NewMigrator(*am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(wasmtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(wasmtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(wasmtypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
+}
+
+// wasmAllowlistMsgServer rejects the instantiation of code IDs and the
+// execution of contracts which are not on the wasm allowlist, and the contract
+// migrations of the senders which are not on the wasm migration allowlist.
+// Governance, the wasm authority, is always allowed.
+type wasmAllowlistMsgServer struct {
+	wasmtypes.MsgServer
+	keeper    *wasmkeeper.Keeper
+	authority string
+	allowlist wasmallowlist.Keeper
+}
+
+func (s wasmAllowlistMsgServer) InstantiateContract(ctx context.Context, msg *wasmtypes.MsgInstantiateContract) (*wasmtypes.MsgInstantiateContractResponse, error) {
+	if msg.Sender != s.authority {
+		allowlist, err := s.allowlist.GetAllowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := allowlist.CheckInstantiate(msg.CodeID); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.InstantiateContract(ctx, msg)
+}
+
+func (s wasmAllowlistMsgServer) InstantiateContract2(ctx context.Context, msg *wasmtypes.MsgInstantiateContract2) (*wasmtypes.MsgInstantiateContract2Response, error) {
+	if msg.Sender != s.authority {
+		allowlist, err := s.allowlist.GetAllowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := allowlist.CheckInstantiate(msg.CodeID); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.InstantiateContract2(ctx, msg)
+}
+
+func (s wasmAllowlistMsgServer) ExecuteContract(ctx context.Context, msg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+	if msg.Sender != s.authority {
+		allowlist, err := s.allowlist.GetAllowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := allowlist.CheckExecute(ctx, s.keeper, msg.Contract); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.ExecuteContract(ctx, msg)
+}
+
+func (s wasmAllowlistMsgServer) MigrateContract(ctx context.Context, msg *wasmtypes.MsgMigrateContract) (*wasmtypes.MsgMigrateContractResponse, error) {
+	if msg.Sender != s.authority {
+		allowlist, err := s.allowlist.GetMigrationAllowlist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := allowlist.CheckMigrator(msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.MigrateContract(ctx, msg)
+}
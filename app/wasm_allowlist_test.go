@@ -0,0 +1,186 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/eve-network/eve/app/wasmallowlist"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestWasmMigrationAllowlistMsgServer(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+	addrs := AddTestAddrsIncremental(app, ctx, 2, sdkmath.NewInt(1_000_000))
+	creator, other := addrs[0], addrs[1]
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+
+	storeCode := func(file string) uint64 {
+		code, err := os.ReadFile(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		codeID, _, err := contractKeeper.Create(ctx, creator, code, nil)
+		require.NoError(t, err)
+		return codeID
+	}
+	reflectCodeID, hackatomCodeID, newCodeID := storeCode("reflect.wasm.gzip"), storeCode("hackatom.wasm.gzip"), storeCode("hackatom.wasm.gzip")
+
+	// the reflect contract is the admin of the hackatom contract, which it
+	// migrates through a submessage
+	reflect, _, err := contractKeeper.Instantiate(ctx, reflectCodeID, creator, nil, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]string{"verifier": creator.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+	hackatom, _, err := contractKeeper.Instantiate(ctx, hackatomCodeID, creator, reflect, initMsg, "hackatom", nil)
+	require.NoError(t, err)
+
+	migrateMsg, err := json.Marshal(map[string]string{"verifier": other.String()})
+	require.NoError(t, err)
+	reflectMsg, err := json.Marshal(map[string]any{
+		"reflect_msg": map[string]any{
+			"msgs": []wasmvmtypes.CosmosMsg{{Wasm: &wasmvmtypes.WasmMsg{Migrate: &wasmvmtypes.MigrateMsg{
+				ContractAddr: hackatom.String(),
+				NewCodeID:    newCodeID,
+				Msg:          migrateMsg,
+			}}}},
+		},
+	})
+	require.NoError(t, err)
+
+	updateMigrationAllowlist := func(allowlist wasmallowlist.MigrationAllowlist) error {
+		msg := &wasmallowlist.MsgUpdateMigrationAllowlist{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), MigrationAllowlist: allowlist}
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+	require.NoError(t, updateMigrationAllowlist(wasmallowlist.MigrationAllowlist{AllowedMigrators: []string{creator.String()}}))
+
+	// a contract which isn't on the allowlist can't migrate
+	_, err = contractKeeper.Execute(ctx, reflect, creator, reflectMsg, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, hackatomCodeID, app.WasmKeeper.GetContractInfo(ctx, hackatom).CodeID)
+
+	// neither can an account sending the message outside of a tx
+	migrate := &wasmtypes.MsgMigrateContract{Sender: other.String(), Contract: hackatom.String(), CodeID: newCodeID, Msg: migrateMsg}
+	_, err = app.MsgServiceRouter().Handler(migrate)(ctx, migrate)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// once allowed, the contract migrates
+	require.NoError(t, updateMigrationAllowlist(wasmallowlist.MigrationAllowlist{AllowedMigrators: []string{creator.String(), reflect.String()}}))
+	_, err = contractKeeper.Execute(ctx, reflect, creator, reflectMsg, nil)
+	require.NoError(t, err)
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, hackatom).CodeID)
+}
+
+func TestWasmAllowlistMsgServer(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Now().UTC())
+	creator := AddTestAddrsIncremental(app, ctx, 1, sdkmath.NewInt(1_000_000))[0]
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+
+	storeCode := func(file string) uint64 {
+		code, err := os.ReadFile(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		codeID, _, err := contractKeeper.Create(ctx, creator, code, nil)
+		require.NoError(t, err)
+		return codeID
+	}
+	reflectCodeID, hackatomCodeID := storeCode("reflect.wasm.gzip"), storeCode("hackatom.wasm.gzip")
+
+	// the reflect contract is the verifier of the hackatom contract, which it
+	// executes through a submessage
+	reflect, _, err := contractKeeper.Instantiate(ctx, reflectCodeID, creator, nil, []byte(`{}`), "reflect", nil)
+	require.NoError(t, err)
+	initMsg, err := json.Marshal(map[string]string{"verifier": reflect.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+	hackatom, _, err := contractKeeper.Instantiate(ctx, hackatomCodeID, creator, nil, initMsg, "hackatom", nil)
+	require.NoError(t, err)
+
+	reflectMsg := func(msg wasmvmtypes.WasmMsg) []byte {
+		bz, err := json.Marshal(map[string]any{
+			"reflect_msg": map[string]any{"msgs": []wasmvmtypes.CosmosMsg{{Wasm: &msg}}},
+		})
+		require.NoError(t, err)
+		return bz
+	}
+	instantiate := reflectMsg(wasmvmtypes.WasmMsg{Instantiate: &wasmvmtypes.InstantiateMsg{CodeID: hackatomCodeID, Msg: initMsg, Label: "hackatom"}})
+	execute := reflectMsg(wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{ContractAddr: hackatom.String(), Msg: []byte(`{"release":{}}`)}})
+
+	updateAllowlist := func(allowlist wasmallowlist.Allowlist) error {
+		msg := &wasmallowlist.MsgUpdateAllowlist{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Allowlist: allowlist}
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	// only the reflect code is allowed, contracts can't go around it
+	require.NoError(t, updateAllowlist(wasmallowlist.Allowlist{AllowedCodeIDs: []uint64{reflectCodeID}}))
+	_, err = contractKeeper.Execute(ctx, reflect, creator, instantiate, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = contractKeeper.Execute(ctx, reflect, creator, execute, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// once allowed, they can
+	require.NoError(t, updateAllowlist(wasmallowlist.Allowlist{AllowedCodeIDs: []uint64{reflectCodeID, hackatomCodeID}}))
+	_, err = contractKeeper.Execute(ctx, reflect, creator, instantiate, nil)
+	require.NoError(t, err)
+	_, err = contractKeeper.Execute(ctx, reflect, creator, execute, nil)
+	require.NoError(t, err)
+}
+
+func TestWasmAllowlistUpdate(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := authtypes.NewModuleAddress("contract").String()
+	updateAllowlist := func(msg *wasmallowlist.MsgUpdateAllowlist) error {
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	allowlist := wasmallowlist.Allowlist{AllowedCodeIDs: []uint64{1}, AllowedContracts: []string{contract}}
+	require.ErrorIs(t, updateAllowlist(&wasmallowlist.MsgUpdateAllowlist{Authority: contract, Allowlist: allowlist}), govtypes.ErrInvalidSigner)
+	require.ErrorIs(t, updateAllowlist(&wasmallowlist.MsgUpdateAllowlist{Authority: authority, Allowlist: wasmallowlist.Allowlist{AllowedCodeIDs: []uint64{0}}}), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, updateAllowlist(&wasmallowlist.MsgUpdateAllowlist{Authority: authority, Allowlist: wasmallowlist.Allowlist{AllowedContracts: []string{"invalid"}}}), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, updateAllowlist(&wasmallowlist.MsgUpdateAllowlist{Authority: authority, Allowlist: allowlist}))
+
+	res, err := wasmallowlist.NewQueryServerImpl(app.WasmAllowlistKeeper).Allowlist(ctx, &wasmallowlist.QueryAllowlistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, allowlist, res.Allowlist)
+
+	updateMigrationAllowlist := func(msg *wasmallowlist.MsgUpdateMigrationAllowlist) error {
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+	migrationAllowlist := wasmallowlist.MigrationAllowlist{AllowedMigrators: []string{contract}}
+	require.ErrorIs(t, updateMigrationAllowlist(&wasmallowlist.MsgUpdateMigrationAllowlist{Authority: contract, MigrationAllowlist: migrationAllowlist}), govtypes.ErrInvalidSigner)
+	require.ErrorIs(t, updateMigrationAllowlist(&wasmallowlist.MsgUpdateMigrationAllowlist{Authority: authority, MigrationAllowlist: wasmallowlist.MigrationAllowlist{AllowedMigrators: []string{"invalid"}}}), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, updateMigrationAllowlist(&wasmallowlist.MsgUpdateMigrationAllowlist{Authority: authority, MigrationAllowlist: migrationAllowlist}))
+
+	migrationRes, err := wasmallowlist.NewQueryServerImpl(app.WasmAllowlistKeeper).MigrationAllowlist(ctx, &wasmallowlist.QueryMigrationAllowlistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, migrationAllowlist, migrationRes.MigrationAllowlist)
+
+	// the allowlists are exported and imported back
+	exported, err := app.WasmAllowlistKeeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, app.WasmAllowlistKeeper.SetAllowlist(ctx, wasmallowlist.Allowlist{}))
+	require.NoError(t, app.WasmAllowlistKeeper.SetMigrationAllowlist(ctx, wasmallowlist.MigrationAllowlist{}))
+	require.NoError(t, app.WasmAllowlistKeeper.InitGenesis(ctx, *exported))
+	imported, err := app.WasmAllowlistKeeper.GetAllowlist(ctx)
+	require.NoError(t, err)
+	require.Equal(t, allowlist, imported)
+	importedMigration, err := app.WasmAllowlistKeeper.GetMigrationAllowlist(ctx)
+	require.NoError(t, err)
+	require.Equal(t, migrationAllowlist, importedMigration)
+}
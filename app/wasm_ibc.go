@@ -0,0 +1,37 @@
+package app
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WasmIBCMaxPacketSize is the maximum size in bytes of the data of a packet
+// received by a wasm contract. It changes the packet acknowledgements, so it is
+// part of consensus and not a node setting.
+const WasmIBCMaxPacketSize = 64 * 1024
+
+// WasmPacketSizeLimitMiddleware rejects received packets whose data is larger
+// than maxSize bytes with an error acknowledgement, before they are dispatched
+// to the contract.
+type WasmPacketSizeLimitMiddleware struct {
+	porttypes.IBCModule
+	maxSize int
+}
+
+func NewWasmPacketSizeLimitMiddleware(app porttypes.IBCModule, maxSize int) WasmPacketSizeLimitMiddleware {
+	return WasmPacketSizeLimitMiddleware{IBCModule: app, maxSize: maxSize}
+}
+
+func (im WasmPacketSizeLimitMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	if size := len(packet.GetData()); size > im.maxSize {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "wasm packet data of %d bytes exceeds the maximum of %d", size, im.maxSize))
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
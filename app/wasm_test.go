@@ -0,0 +1,124 @@
+package app
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestQueryGasCeilingDecorator(t *testing.T) {
+	const ceiling = 1000
+	smartQuery := wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{ContractAddr: "contract", Msg: []byte("{}")}}}
+	bankQuery := wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{Balance: &wasmvmtypes.BalanceQuery{Address: "addr", Denom: "ueve"}}}
+
+	testCases := []struct {
+		name     string
+		execMode sdk.ExecMode
+		request  wasmvmtypes.QueryRequest
+		gas      storetypes.Gas
+		expErr   error
+		expGas   storetypes.Gas
+	}{
+		{"simulate under the ceiling, should pass", sdk.ExecModeSimulate, smartQuery, 600, nil, 600},
+		{"simulate over the ceiling, should fail", sdk.ExecModeSimulate, smartQuery, 1500, sdkerrors.ErrOutOfGas, ceiling},
+		{"check over the ceiling, should fail", sdk.ExecModeCheck, smartQuery, 1500, sdkerrors.ErrOutOfGas, ceiling},
+		{"finalize over the ceiling, should pass", sdk.ExecModeFinalize, smartQuery, 1500, nil, 1500},
+		{"non wasm query over the ceiling, should pass", sdk.ExecModeSimulate, bankQuery, 1500, nil, 1500},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithExecMode(tc.execMode).WithGasMeter(storetypes.NewGasMeter(1_000_000))
+			inner := wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, _ sdk.AccAddress, _ wasmvmtypes.QueryRequest) ([]byte, error) {
+				ctx.GasMeter().ConsumeGas(tc.gas, "test")
+				return []byte("{}"), nil
+			})
+
+			handler := NewQueryGasCeilingDecorator(ceiling)(inner)
+			res, err := handler.HandleQuery(ctx, nil, tc.request)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []byte("{}"), res)
+			}
+			require.Equal(t, tc.expGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+
+	// running out of the tx gas is not turned into a query error
+	ctx := sdk.Context{}.WithExecMode(sdk.ExecModeSimulate).WithGasMeter(storetypes.NewGasMeter(500))
+	inner := wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, _ sdk.AccAddress, _ wasmvmtypes.QueryRequest) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(800, "test")
+		return nil, nil
+	})
+	require.Panics(t, func() {
+		_, _ = NewQueryGasCeilingDecorator(ceiling)(inner).HandleQuery(ctx, nil, smartQuery)
+	})
+}
+
+func TestGenesisPinnedCodes(t *testing.T) {
+	code, err := os.ReadFile(filepath.Join("testdata", "hackatom.wasm.gzip"))
+	require.NoError(t, err)
+	unzipped, err := ioutils.Uncompress(code, math.MaxInt64)
+	require.NoError(t, err)
+	checksum, err := wasmvm.CreateChecksum(unzipped)
+	require.NoError(t, err)
+	codeInfo := wasmtypes.CodeInfo{
+		CodeHash:          checksum,
+		Creator:           "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du",
+		InstantiateConfig: wasmtypes.AllowEverybody,
+	}
+
+	// codes are pinned at genesis with the pinned flag of the wasm genesis
+	app := SetupWithEmptyStore(t)
+	genesisState := GenesisStateWithSingleValidator(t, app)
+	wasmGenesis := wasmtypes.GenesisState{
+		Params: wasmtypes.DefaultParams(),
+		Codes: []wasmtypes.Code{
+			{CodeID: 1, CodeInfo: codeInfo, CodeBytes: code, Pinned: true},
+			{CodeID: 2, CodeInfo: codeInfo, CodeBytes: code},
+		},
+		Sequences: []wasmtypes.Sequence{
+			{IDKey: wasmtypes.KeySequenceCodeID, Value: 3},
+			{IDKey: wasmtypes.KeySequenceInstanceID, Value: 1},
+		},
+	}
+	genesisState[wasmtypes.ModuleName] = app.AppCodec().MustMarshalJSON(&wasmGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         "testing",
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	require.True(t, app.WasmKeeper.IsPinnedCode(ctx, 1))
+	require.False(t, app.WasmKeeper.IsPinnedCode(ctx, 2))
+
+	// and exported with it
+	exported := wasmkeeper.ExportGenesis(ctx, &app.WasmKeeper)
+	require.Len(t, exported.Codes, 2)
+	require.True(t, exported.Codes[0].Pinned)
+	require.False(t, exported.Codes[1].Pinned)
+}
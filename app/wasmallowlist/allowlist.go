@@ -0,0 +1,83 @@
+package wasmallowlist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// ContractInfoKeeper defines the wasm keeper method used to look up the code ID
+// of an executed contract.
+type ContractInfoKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// IsEmpty returns true when the allowlist restricts nothing.
+func (a Allowlist) IsEmpty() bool {
+	return len(a.AllowedCodeIDs) == 0 && len(a.AllowedContracts) == 0
+}
+
+// Validate checks that the code IDs are positive and the contracts are valid
+// addresses.
+func (a Allowlist) Validate() error {
+	for _, codeID := range a.AllowedCodeIDs {
+		if codeID == 0 {
+			return fmt.Errorf("code id must be positive")
+		}
+	}
+	for _, contract := range a.AllowedContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid contract address %s: %w", contract, err)
+		}
+	}
+	return nil
+}
+
+// CheckInstantiate returns an error when the allowlist isn't empty and doesn't
+// list the code ID.
+func (a Allowlist) CheckInstantiate(codeID uint64) error {
+	if a.IsEmpty() || slices.Contains(a.AllowedCodeIDs, codeID) {
+		return nil
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "code id %d is not allowed", codeID)
+}
+
+// CheckExecute returns an error when the allowlist isn't empty and lists
+// neither the contract nor its code ID.
+func (a Allowlist) CheckExecute(ctx context.Context, wasmKeeper ContractInfoKeeper, contract string) error {
+	if a.IsEmpty() || slices.Contains(a.AllowedContracts, contract) {
+		return nil
+	}
+	if addr, err := sdk.AccAddressFromBech32(contract); err == nil {
+		if info := wasmKeeper.GetContractInfo(ctx, addr); info != nil && slices.Contains(a.AllowedCodeIDs, info.CodeID) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "contract %s is not allowed", contract)
+}
+
+// Validate checks that the migrators are valid addresses.
+func (a MigrationAllowlist) Validate() error {
+	for _, migrator := range a.AllowedMigrators {
+		if _, err := sdk.AccAddressFromBech32(migrator); err != nil {
+			return fmt.Errorf("invalid migrator address %s: %w", migrator, err)
+		}
+	}
+	return nil
+}
+
+// CheckMigrator returns an error when the allowlist isn't empty and doesn't
+// list the sender.
+func (a MigrationAllowlist) CheckMigrator(sender string) error {
+	if len(a.AllowedMigrators) == 0 || slices.Contains(a.AllowedMigrators, sender) {
+		return nil
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to migrate contracts", sender)
+}
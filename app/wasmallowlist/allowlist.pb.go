@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/wasmallowlist/v1/allowlist.proto
+
+package wasmallowlist
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Allowlist lists the code IDs that can be instantiated and the contracts that
+// can be executed. The instances of an allowed code ID can be executed too.
+// Governance is always allowed and an empty allowlist allows everything.
+type Allowlist struct {
+	// allowed_code_ids lists the code IDs that can be instantiated.
+	AllowedCodeIDs []uint64 `protobuf:"varint,1,rep,packed,name=allowed_code_ids,json=allowedCodeIds,proto3" json:"allowed_code_ids,omitempty"`
+	// allowed_contracts lists the contracts that can be executed.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *Allowlist) Reset()         { *m = Allowlist{} }
+func (m *Allowlist) String() string { return proto.CompactTextString(m) }
+func (*Allowlist) ProtoMessage()    {}
+func (*Allowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb1ccd8448d5d8c2, []int{0}
+}
+func (m *Allowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowlist.Merge(m, src)
+}
+func (m *Allowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowlist proto.InternalMessageInfo
+
+func (m *Allowlist) GetAllowedCodeIDs() []uint64 {
+	if m != nil {
+		return m.AllowedCodeIDs
+	}
+	return nil
+}
+
+func (m *Allowlist) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+// MigrationAllowlist lists the addresses that can migrate contracts, on top of
+// governance, which is always allowed. An empty allowlist allows every
+// contract admin.
+type MigrationAllowlist struct {
+	// allowed_migrators lists the addresses that can migrate contracts.
+	AllowedMigrators []string `protobuf:"bytes,1,rep,name=allowed_migrators,json=allowedMigrators,proto3" json:"allowed_migrators,omitempty"`
+}
+
+func (m *MigrationAllowlist) Reset()         { *m = MigrationAllowlist{} }
+func (m *MigrationAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MigrationAllowlist) ProtoMessage()    {}
+func (*MigrationAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb1ccd8448d5d8c2, []int{1}
+}
+func (m *MigrationAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrationAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrationAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationAllowlist.Merge(m, src)
+}
+func (m *MigrationAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrationAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationAllowlist proto.InternalMessageInfo
+
+func (m *MigrationAllowlist) GetAllowedMigrators() []string {
+	if m != nil {
+		return m.AllowedMigrators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Allowlist)(nil), "eve.wasmallowlist.v1.Allowlist")
+	proto.RegisterType((*MigrationAllowlist)(nil), "eve.wasmallowlist.v1.MigrationAllowlist")
+}
+
+func init() {
+	proto.RegisterFile("eve/wasmallowlist/v1/allowlist.proto", fileDescriptor_eb1ccd8448d5d8c2)
+}
+
+var fileDescriptor_eb1ccd8448d5d8c2 = []byte{
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0x4b, 0xd5,
+	0x2f, 0x4f, 0x2c, 0xce, 0x4d, 0xcc, 0xc9, 0xc9, 0x2f, 0xcf, 0xc9, 0x2c, 0x2e, 0xd1, 0x2f, 0x33,
+	0xd4, 0x87, 0x73, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x52, 0xcb, 0x52, 0xf5, 0x50,
+	0x54, 0xe9, 0x95, 0x19, 0x4a, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0xd5, 0xe8,
+	0x43, 0x38, 0x10, 0x0d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b, 0x22, 0xaa,
+	0x34, 0x81, 0x91, 0x8b, 0xd3, 0x11, 0x66, 0x82, 0x90, 0x0d, 0x97, 0x00, 0xd8, 0xb8, 0xd4, 0x94,
+	0xf8, 0xe4, 0xfc, 0x94, 0xd4, 0xf8, 0xcc, 0x94, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x16, 0x27,
+	0xa1, 0x47, 0xf7, 0xe4, 0xf9, 0x1c, 0x21, 0x72, 0xce, 0xf9, 0x29, 0xa9, 0x9e, 0x2e, 0xc5, 0x41,
+	0x7c, 0x89, 0x48, 0xfc, 0x94, 0x62, 0x21, 0x57, 0x2e, 0x41, 0x84, 0xee, 0xbc, 0x92, 0xa2, 0xc4,
+	0xe4, 0x92, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0,
+	0xce, 0x71, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12,
+	0x80, 0x1b, 0x02, 0xd5, 0xa1, 0x14, 0xcd, 0x25, 0xe4, 0x9b, 0x99, 0x5e, 0x94, 0x58, 0x92, 0x99,
+	0x9f, 0x87, 0x70, 0x1a, 0x92, 0xe1, 0xb9, 0x60, 0xd9, 0xfc, 0x22, 0x88, 0xdb, 0x88, 0x31, 0xdc,
+	0x17, 0xa6, 0xc3, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
+	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74,
+	0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x53, 0xcb, 0x52, 0x75, 0xf3,
+	0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0x41, 0x6c, 0xfd, 0xc4, 0x82, 0x02, 0xd4, 0x18, 0x49, 0x62,
+	0x03, 0x07, 0x9f, 0x31, 0x60, 0x00, 0x27, 0x57, 0x9a, 0xa5, 0xad, 0x01, 0x00, 0x00,
+}
+
+func (m *Allowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintAllowlist(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedCodeIDs) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedCodeIDs)*10)
+		var j1 int
+		for _, num := range m.AllowedCodeIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAllowlist(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMigrators) > 0 {
+		for iNdEx := len(m.AllowedMigrators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMigrators[iNdEx])
+			copy(dAtA[i:], m.AllowedMigrators[iNdEx])
+			i = encodeVarintAllowlist(dAtA, i, uint64(len(m.AllowedMigrators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowlist(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowlist(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Allowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedCodeIDs) > 0 {
+		l = 0
+		for _, e := range m.AllowedCodeIDs {
+			l += sovAllowlist(uint64(e))
+		}
+		n += 1 + sovAllowlist(uint64(l)) + l
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovAllowlist(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MigrationAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMigrators) > 0 {
+		for _, s := range m.AllowedMigrators {
+			l = len(s)
+			n += 1 + l + sovAllowlist(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAllowlist(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowlist(x uint64) (n int) {
+	return sovAllowlist(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Allowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAllowlist
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedCodeIDs = append(m.AllowedCodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAllowlist
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAllowlist
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAllowlist
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedCodeIDs) == 0 {
+					m.AllowedCodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAllowlist
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedCodeIDs = append(m.AllowedCodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeIDs", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowlist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigrationAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMigrators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMigrators = append(m.AllowedMigrators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowlist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowlist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowlist(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowlist
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowlist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowlist
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowlist
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowlist
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowlist        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowlist          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowlist = fmt.Errorf("proto: unexpected end of group")
+)
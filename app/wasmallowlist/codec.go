@@ -0,0 +1,13 @@
+package wasmallowlist
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the wasm allowlist messages.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateAllowlist{}, &MsgUpdateMigrationAllowlist{})
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,37 @@
+package wasmallowlist
+
+import (
+	"context"
+)
+
+// DefaultGenesisState returns the default wasm allowlist genesis state, with
+// empty allowlists allowing everything.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate validates the genesis allowlists.
+func (gs GenesisState) Validate() error {
+	if err := gs.Allowlist.Validate(); err != nil {
+		return err
+	}
+	return gs.MigrationAllowlist.Validate()
+}
+
+// InitGenesis sets the allowlists of the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs GenesisState) error {
+	if err := k.SetAllowlist(ctx, gs.Allowlist); err != nil {
+		return err
+	}
+	return k.SetMigrationAllowlist(ctx, gs.MigrationAllowlist)
+}
+
+// ExportGenesis returns the allowlists as a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*GenesisState, error) {
+	allowlist, err := k.GetAllowlist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrationAllowlist, err := k.GetMigrationAllowlist(ctx)
+	return &GenesisState{Allowlist: allowlist, MigrationAllowlist: migrationAllowlist}, err
+}
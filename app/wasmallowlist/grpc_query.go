@@ -0,0 +1,45 @@
+package wasmallowlist
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns the wasm allowlist Query service implementation.
+func NewQueryServerImpl(keeper Keeper) QueryServer {
+	return queryServer{Keeper: keeper}
+}
+
+// Allowlist returns the wasm allowlist.
+func (k queryServer) Allowlist(ctx context.Context, req *QueryAllowlistRequest) (*QueryAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	allowlist, err := k.GetAllowlist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryAllowlistResponse{Allowlist: allowlist}, nil
+}
+
+// MigrationAllowlist returns the wasm migration allowlist.
+func (k queryServer) MigrationAllowlist(ctx context.Context, req *QueryMigrationAllowlistRequest) (*QueryMigrationAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	allowlist, err := k.GetMigrationAllowlist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryMigrationAllowlistResponse{MigrationAllowlist: allowlist}, nil
+}
@@ -0,0 +1,90 @@
+package wasmallowlist
+
+import (
+	"context"
+
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// ModuleName is the name of the wasm allowlist module.
+	ModuleName = "wasmallowlist"
+
+	// StoreKey is the key of the store holding the wasm allowlist. Unlike the
+	// module name, it can't start with wasm, the key of the wasm store.
+	StoreKey = "contractallowlist"
+)
+
+var (
+	// AllowlistKey is the store key of the wasm allowlist.
+	AllowlistKey = []byte{0x01}
+	// MigrationAllowlistKey is the store key of the wasm migration allowlist.
+	MigrationAllowlistKey = []byte{0x02}
+)
+
+// Keeper manages the governance controlled wasm allowlist and wasm migration
+// allowlist.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService corestore.KVStoreService
+	authority    string
+}
+
+// NewKeeper returns the wasm allowlist keeper. Only authority can update the
+// allowlists.
+func NewKeeper(cdc codec.BinaryCodec, storeService corestore.KVStoreService, authority string) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the allowlists.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetAllowlist returns the wasm allowlist. It is empty, allowing everything,
+// until set.
+func (k Keeper) GetAllowlist(ctx context.Context) (Allowlist, error) {
+	var allowlist Allowlist
+	bz, err := k.storeService.OpenKVStore(ctx).Get(AllowlistKey)
+	if err != nil || bz == nil {
+		return allowlist, err
+	}
+	err = k.cdc.Unmarshal(bz, &allowlist)
+	return allowlist, err
+}
+
+// SetAllowlist sets the wasm allowlist.
+func (k Keeper) SetAllowlist(ctx context.Context, allowlist Allowlist) error {
+	bz, err := k.cdc.Marshal(&allowlist)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(AllowlistKey, bz)
+}
+
+// GetMigrationAllowlist returns the wasm migration allowlist. It is empty,
+// allowing every contract admin, until set.
+func (k Keeper) GetMigrationAllowlist(ctx context.Context) (MigrationAllowlist, error) {
+	var allowlist MigrationAllowlist
+	bz, err := k.storeService.OpenKVStore(ctx).Get(MigrationAllowlistKey)
+	if err != nil || bz == nil {
+		return allowlist, err
+	}
+	err = k.cdc.Unmarshal(bz, &allowlist)
+	return allowlist, err
+}
+
+// SetMigrationAllowlist sets the wasm migration allowlist.
+func (k Keeper) SetMigrationAllowlist(ctx context.Context, allowlist MigrationAllowlist) error {
+	bz, err := k.cdc.Marshal(&allowlist)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(MigrationAllowlistKey, bz)
+}
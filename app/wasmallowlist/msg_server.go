@@ -0,0 +1,52 @@
+package wasmallowlist
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns the wasm allowlist Msg service implementation.
+func NewMsgServerImpl(keeper Keeper) MsgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// UpdateAllowlist replaces the wasm allowlist with msg.Allowlist.
+func (k msgServer) UpdateAllowlist(ctx context.Context, msg *MsgUpdateAllowlist) (*MsgUpdateAllowlistResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Allowlist.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.SetAllowlist(ctx, msg.Allowlist); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateAllowlistResponse{}, nil
+}
+
+// UpdateMigrationAllowlist replaces the wasm migration allowlist with
+// msg.MigrationAllowlist.
+func (k msgServer) UpdateMigrationAllowlist(ctx context.Context, msg *MsgUpdateMigrationAllowlist) (*MsgUpdateMigrationAllowlistResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.MigrationAllowlist.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.SetMigrationAllowlist(ctx, msg.MigrationAllowlist); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateMigrationAllowlistResponse{}, nil
+}
@@ -0,0 +1,874 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/wasmallowlist/v1/query.proto
+
+package wasmallowlist
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAllowlistRequest is the Query/Allowlist request type.
+type QueryAllowlistRequest struct {
+}
+
+func (m *QueryAllowlistRequest) Reset()         { *m = QueryAllowlistRequest{} }
+func (m *QueryAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistRequest) ProtoMessage()    {}
+func (*QueryAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3f0200e1a6a247b, []int{0}
+}
+func (m *QueryAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistRequest.Merge(m, src)
+}
+func (m *QueryAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistRequest proto.InternalMessageInfo
+
+// QueryAllowlistResponse is the Query/Allowlist response type.
+type QueryAllowlistResponse struct {
+	// allowlist defines the wasm allowlist.
+	Allowlist Allowlist `protobuf:"bytes,1,opt,name=allowlist,proto3" json:"allowlist"`
+}
+
+func (m *QueryAllowlistResponse) Reset()         { *m = QueryAllowlistResponse{} }
+func (m *QueryAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistResponse) ProtoMessage()    {}
+func (*QueryAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3f0200e1a6a247b, []int{1}
+}
+func (m *QueryAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistResponse.Merge(m, src)
+}
+func (m *QueryAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryAllowlistResponse) GetAllowlist() Allowlist {
+	if m != nil {
+		return m.Allowlist
+	}
+	return Allowlist{}
+}
+
+// QueryMigrationAllowlistRequest is the Query/MigrationAllowlist request type.
+type QueryMigrationAllowlistRequest struct {
+}
+
+func (m *QueryMigrationAllowlistRequest) Reset()         { *m = QueryMigrationAllowlistRequest{} }
+func (m *QueryMigrationAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationAllowlistRequest) ProtoMessage()    {}
+func (*QueryMigrationAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3f0200e1a6a247b, []int{2}
+}
+func (m *QueryMigrationAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationAllowlistRequest.Merge(m, src)
+}
+func (m *QueryMigrationAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationAllowlistRequest proto.InternalMessageInfo
+
+// QueryMigrationAllowlistResponse is the Query/MigrationAllowlist response
+// type.
+type QueryMigrationAllowlistResponse struct {
+	// migration_allowlist defines the wasm migration allowlist.
+	MigrationAllowlist MigrationAllowlist `protobuf:"bytes,1,opt,name=migration_allowlist,json=migrationAllowlist,proto3" json:"migration_allowlist"`
+}
+
+func (m *QueryMigrationAllowlistResponse) Reset()         { *m = QueryMigrationAllowlistResponse{} }
+func (m *QueryMigrationAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationAllowlistResponse) ProtoMessage()    {}
+func (*QueryMigrationAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3f0200e1a6a247b, []int{3}
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationAllowlistResponse.Merge(m, src)
+}
+func (m *QueryMigrationAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryMigrationAllowlistResponse) GetMigrationAllowlist() MigrationAllowlist {
+	if m != nil {
+		return m.MigrationAllowlist
+	}
+	return MigrationAllowlist{}
+}
+
+func init() {
+	proto.RegisterType((*QueryAllowlistRequest)(nil), "eve.wasmallowlist.v1.QueryAllowlistRequest")
+	proto.RegisterType((*QueryAllowlistResponse)(nil), "eve.wasmallowlist.v1.QueryAllowlistResponse")
+	proto.RegisterType((*QueryMigrationAllowlistRequest)(nil), "eve.wasmallowlist.v1.QueryMigrationAllowlistRequest")
+	proto.RegisterType((*QueryMigrationAllowlistResponse)(nil), "eve.wasmallowlist.v1.QueryMigrationAllowlistResponse")
+}
+
+func init() { proto.RegisterFile("eve/wasmallowlist/v1/query.proto", fileDescriptor_b3f0200e1a6a247b) }
+
+var fileDescriptor_b3f0200e1a6a247b = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x4a, 0xf3, 0x40,
+	0x18, 0xc7, 0x33, 0xe5, 0x7d, 0x85, 0x8e, 0xbb, 0xb1, 0x7e, 0x10, 0x64, 0x52, 0x83, 0x60, 0xa5,
+	0x35, 0x43, 0xab, 0x1e, 0xc0, 0x0a, 0xee, 0x5c, 0xd8, 0xa5, 0x20, 0x25, 0x95, 0x21, 0x06, 0x93,
+	0x3c, 0x69, 0x32, 0x4d, 0x71, 0xdb, 0x0b, 0x28, 0x78, 0x15, 0x0f, 0xd1, 0x65, 0xc1, 0x8d, 0x2b,
+	0x91, 0xd6, 0x83, 0x48, 0xa7, 0x69, 0x4b, 0x9b, 0x69, 0xd1, 0xdd, 0x30, 0xff, 0x8f, 0xe7, 0x37,
+	0x1f, 0xb8, 0xc8, 0x13, 0xce, 0xba, 0x76, 0xec, 0xdb, 0x9e, 0x07, 0x5d, 0xcf, 0x8d, 0x05, 0x4b,
+	0xaa, 0xac, 0xdd, 0xe1, 0xd1, 0x93, 0x15, 0x46, 0x20, 0x80, 0x14, 0x78, 0xc2, 0xad, 0x05, 0x87,
+	0x95, 0x54, 0xf5, 0x43, 0x65, 0x6e, 0x6e, 0x91, 0x59, 0xbd, 0xe0, 0x80, 0x03, 0x72, 0xc9, 0xc6,
+	0xab, 0x74, 0x77, 0xdf, 0x01, 0x70, 0x3c, 0xce, 0xec, 0xd0, 0x65, 0x76, 0x10, 0x80, 0xb0, 0x85,
+	0x0b, 0x41, 0x3c, 0x51, 0xcd, 0x5d, 0xbc, 0x7d, 0x33, 0x1e, 0x7f, 0x31, 0xed, 0x6a, 0xf0, 0x76,
+	0x87, 0xc7, 0xc2, 0xbc, 0xc3, 0x3b, 0xcb, 0x42, 0x1c, 0x42, 0x10, 0x73, 0x72, 0x89, 0xf3, 0xb3,
+	0xc9, 0x7b, 0xa8, 0x88, 0x4a, 0x9b, 0x35, 0xc3, 0x52, 0x61, 0x5b, 0xb3, 0x6c, 0xfd, 0x5f, 0xff,
+	0xd3, 0xd0, 0x1a, 0xf3, 0x9c, 0x59, 0xc4, 0x54, 0xd6, 0x5f, 0xbb, 0x4e, 0x24, 0x81, 0x32, 0x00,
+	0x3d, 0x84, 0x8d, 0x95, 0x96, 0x14, 0xa5, 0x89, 0xb7, 0xfc, 0xa9, 0xda, 0x5c, 0x86, 0x2a, 0xa9,
+	0xa1, 0xb2, 0x75, 0x29, 0x1d, 0xf1, 0x33, 0x4a, 0x6d, 0x90, 0xc3, 0xff, 0x25, 0x04, 0x79, 0x46,
+	0x38, 0x3f, 0xdb, 0x27, 0x65, 0x75, 0xb7, 0xf2, 0x2a, 0xf5, 0xca, 0xef, 0xcc, 0x93, 0x33, 0x99,
+	0x47, 0xbd, 0xf7, 0xef, 0xd7, 0xdc, 0x01, 0x31, 0xd8, 0xfa, 0x47, 0x27, 0x6f, 0x08, 0x93, 0xec,
+	0x61, 0xc8, 0xd9, 0x9a, 0x69, 0x2b, 0x6f, 0x5b, 0x3f, 0xff, 0x63, 0x2a, 0x85, 0xad, 0x4a, 0xd8,
+	0x32, 0x39, 0x56, 0xc3, 0x2a, 0x1e, 0xa7, 0x7e, 0xd5, 0x1f, 0x52, 0x34, 0x18, 0x52, 0xf4, 0x35,
+	0xa4, 0xe8, 0x65, 0x44, 0xb5, 0xc1, 0x88, 0x6a, 0x1f, 0x23, 0xaa, 0xdd, 0x56, 0x1c, 0x57, 0x3c,
+	0x74, 0x5a, 0xd6, 0x3d, 0xf8, 0xe3, 0xba, 0x93, 0x80, 0x8b, 0x2e, 0x44, 0x8f, 0xb2, 0xda, 0x0e,
+	0xc3, 0xc5, 0xfa, 0xd6, 0x86, 0xfc, 0xc0, 0xa7, 0x3f, 0x03, 0x00, 0xa3, 0x3b, 0xd9, 0x5b, 0x54,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Allowlist returns the wasm allowlist.
+	Allowlist(ctx context.Context, in *QueryAllowlistRequest, opts ...grpc.CallOption) (*QueryAllowlistResponse, error)
+	// MigrationAllowlist returns the wasm migration allowlist.
+	MigrationAllowlist(ctx context.Context, in *QueryMigrationAllowlistRequest, opts ...grpc.CallOption) (*QueryMigrationAllowlistResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Allowlist(ctx context.Context, in *QueryAllowlistRequest, opts ...grpc.CallOption) (*QueryAllowlistResponse, error) {
+	out := new(QueryAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/eve.wasmallowlist.v1.Query/Allowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MigrationAllowlist(ctx context.Context, in *QueryMigrationAllowlistRequest, opts ...grpc.CallOption) (*QueryMigrationAllowlistResponse, error) {
+	out := new(QueryMigrationAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/eve.wasmallowlist.v1.Query/MigrationAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Allowlist returns the wasm allowlist.
+	Allowlist(context.Context, *QueryAllowlistRequest) (*QueryAllowlistResponse, error)
+	// MigrationAllowlist returns the wasm migration allowlist.
+	MigrationAllowlist(context.Context, *QueryMigrationAllowlistRequest) (*QueryMigrationAllowlistResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Allowlist(ctx context.Context, req *QueryAllowlistRequest) (*QueryAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+}
+func (*UnimplementedQueryServer) MigrationAllowlist(ctx context.Context, req *QueryMigrationAllowlistRequest) (*QueryMigrationAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationAllowlist not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.wasmallowlist.v1.Query/Allowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlist(ctx, req.(*QueryAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MigrationAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.wasmallowlist.v1.Query/MigrationAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationAllowlist(ctx, req.(*QueryMigrationAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eve.wasmallowlist.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Allowlist",
+			Handler:    _Query_Allowlist_Handler,
+		},
+		{
+			MethodName: "MigrationAllowlist",
+			Handler:    _Query_MigrationAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eve/wasmallowlist/v1/query.proto",
+}
+
+func (m *QueryAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigrationAllowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowlist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMigrationAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMigrationAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MigrationAllowlist.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MigrationAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eve/wasmallowlist/v1/tx.proto
+
+package wasmallowlist
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateAllowlist is the Msg/UpdateAllowlist request type.
+type MsgUpdateAllowlist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// allowlist defines the new wasm allowlist.
+	Allowlist Allowlist `protobuf:"bytes,2,opt,name=allowlist,proto3" json:"allowlist"`
+}
+
+func (m *MsgUpdateAllowlist) Reset()         { *m = MsgUpdateAllowlist{} }
+func (m *MsgUpdateAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlist) ProtoMessage()    {}
+func (*MsgUpdateAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36022a2d8f2d5fa, []int{0}
+}
+func (m *MsgUpdateAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowlist) GetAllowlist() Allowlist {
+	if m != nil {
+		return m.Allowlist
+	}
+	return Allowlist{}
+}
+
+// MsgUpdateAllowlistResponse is the Msg/UpdateAllowlist response type.
+type MsgUpdateAllowlistResponse struct {
+}
+
+func (m *MsgUpdateAllowlistResponse) Reset()         { *m = MsgUpdateAllowlistResponse{} }
+func (m *MsgUpdateAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36022a2d8f2d5fa, []int{1}
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowlistResponse proto.InternalMessageInfo
+
+// MsgUpdateMigrationAllowlist is the Msg/UpdateMigrationAllowlist request type.
+type MsgUpdateMigrationAllowlist struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// migration_allowlist defines the new wasm migration allowlist.
+	MigrationAllowlist MigrationAllowlist `protobuf:"bytes,2,opt,name=migration_allowlist,json=migrationAllowlist,proto3" json:"migration_allowlist"`
+}
+
+func (m *MsgUpdateMigrationAllowlist) Reset()         { *m = MsgUpdateMigrationAllowlist{} }
+func (m *MsgUpdateMigrationAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMigrationAllowlist) ProtoMessage()    {}
+func (*MsgUpdateMigrationAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36022a2d8f2d5fa, []int{2}
+}
+func (m *MsgUpdateMigrationAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMigrationAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMigrationAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMigrationAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMigrationAllowlist.Merge(m, src)
+}
+func (m *MsgUpdateMigrationAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMigrationAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMigrationAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMigrationAllowlist proto.InternalMessageInfo
+
+func (m *MsgUpdateMigrationAllowlist) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMigrationAllowlist) GetMigrationAllowlist() MigrationAllowlist {
+	if m != nil {
+		return m.MigrationAllowlist
+	}
+	return MigrationAllowlist{}
+}
+
+// MsgUpdateMigrationAllowlistResponse is the Msg/UpdateMigrationAllowlist
+// response type.
+type MsgUpdateMigrationAllowlistResponse struct {
+}
+
+func (m *MsgUpdateMigrationAllowlistResponse) Reset()         { *m = MsgUpdateMigrationAllowlistResponse{} }
+func (m *MsgUpdateMigrationAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMigrationAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdateMigrationAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36022a2d8f2d5fa, []int{3}
+}
+func (m *MsgUpdateMigrationAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMigrationAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMigrationAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMigrationAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMigrationAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdateMigrationAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMigrationAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMigrationAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMigrationAllowlistResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateAllowlist)(nil), "eve.wasmallowlist.v1.MsgUpdateAllowlist")
+	proto.RegisterType((*MsgUpdateAllowlistResponse)(nil), "eve.wasmallowlist.v1.MsgUpdateAllowlistResponse")
+	proto.RegisterType((*MsgUpdateMigrationAllowlist)(nil), "eve.wasmallowlist.v1.MsgUpdateMigrationAllowlist")
+	proto.RegisterType((*MsgUpdateMigrationAllowlistResponse)(nil), "eve.wasmallowlist.v1.MsgUpdateMigrationAllowlistResponse")
+}
+
+func init() { proto.RegisterFile("eve/wasmallowlist/v1/tx.proto", fileDescriptor_a36022a2d8f2d5fa) }
+
+var fileDescriptor_a36022a2d8f2d5fa = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x4b, 0x3a, 0x41,
+	0x18, 0xdf, 0xf1, 0xff, 0x02, 0x4e, 0x50, 0xb0, 0x09, 0x6d, 0x5b, 0xad, 0x62, 0x05, 0x22, 0xb9,
+	0x93, 0x06, 0x41, 0xdd, 0x34, 0xe8, 0xe6, 0xc5, 0xe8, 0xd2, 0x45, 0x56, 0x1d, 0xc6, 0x25, 0x67,
+	0x67, 0xd9, 0x19, 0xd7, 0xba, 0x45, 0x87, 0xe8, 0xd8, 0x57, 0xe8, 0x1b, 0x78, 0xe8, 0x33, 0x84,
+	0x47, 0xe9, 0xd4, 0x29, 0x42, 0x0f, 0x7e, 0x8d, 0xd0, 0x75, 0x5d, 0x74, 0xb5, 0x17, 0xba, 0xcd,
+	0xec, 0xef, 0xed, 0xf9, 0xcd, 0xf2, 0xc0, 0x2d, 0xec, 0x62, 0xd4, 0x32, 0x38, 0x35, 0x1a, 0x0d,
+	0xd6, 0x6a, 0x98, 0x5c, 0x20, 0x37, 0x8b, 0xc4, 0x95, 0x6e, 0x3b, 0x4c, 0x30, 0x39, 0x86, 0x5d,
+	0xac, 0x4f, 0xc1, 0xba, 0x9b, 0x55, 0xd7, 0xaa, 0x8c, 0x53, 0xc6, 0x11, 0xe5, 0x64, 0xc8, 0xa6,
+	0x9c, 0x78, 0x74, 0x75, 0xdd, 0x03, 0xca, 0xa3, 0x1b, 0xf2, 0x2e, 0x63, 0x68, 0x67, 0x6e, 0x50,
+	0x60, 0xeb, 0xb1, 0x62, 0x84, 0x11, 0xe6, 0xa9, 0x87, 0x27, 0xef, 0x6b, 0xf2, 0x11, 0x40, 0xb9,
+	0xc8, 0xc9, 0xb9, 0x5d, 0x33, 0x04, 0xce, 0xfb, 0x12, 0xf9, 0x10, 0x46, 0x8d, 0xa6, 0xa8, 0x33,
+	0xc7, 0x14, 0xd7, 0x0a, 0x48, 0x80, 0x54, 0xb4, 0xa0, 0xbc, 0x3c, 0x65, 0x62, 0xe3, 0xdc, 0x7c,
+	0xad, 0xe6, 0x60, 0xce, 0xcf, 0x84, 0x63, 0x5a, 0xa4, 0x14, 0x50, 0xe5, 0x13, 0x18, 0x9d, 0xe4,
+	0x2a, 0x91, 0x04, 0x48, 0x2d, 0xe5, 0xe2, 0xfa, 0xbc, 0xa2, 0xfa, 0x24, 0xab, 0xf0, 0xb7, 0xf3,
+	0x16, 0x97, 0x4a, 0x81, 0xee, 0x78, 0xf9, 0x76, 0xd0, 0x4e, 0x07, 0xa6, 0xc9, 0x4d, 0xa8, 0x86,
+	0x47, 0x2c, 0x61, 0x6e, 0x33, 0x8b, 0xe3, 0xe4, 0x33, 0x80, 0x1b, 0x13, 0xb8, 0x68, 0x12, 0xc7,
+	0x10, 0x26, 0xb3, 0x7e, 0x5f, 0xa5, 0x0c, 0x57, 0xa9, 0xef, 0x56, 0x9e, 0x2d, 0x95, 0x9a, 0x5f,
+	0x2a, 0x1c, 0x3f, 0x6e, 0x27, 0xd3, 0x10, 0x12, 0xaa, 0xb9, 0x0b, 0xb7, 0x3f, 0xe9, 0xe1, 0xf7,
+	0xcd, 0xdd, 0x45, 0xe0, 0x9f, 0x22, 0x27, 0x32, 0x85, 0x2b, 0xb3, 0x7f, 0x6d, 0xd1, 0x54, 0xa1,
+	0xc7, 0x53, 0xf7, 0xbf, 0xcb, 0xf4, 0x63, 0xe5, 0x7b, 0x00, 0x95, 0x85, 0x6f, 0x9c, 0xfd, 0xc2,
+	0x2e, 0x2c, 0x51, 0x8f, 0x7e, 0x2c, 0xf1, 0x47, 0x51, 0xff, 0xdd, 0x0c, 0xda, 0x69, 0x50, 0x38,
+	0xed, 0xf4, 0x34, 0xd0, 0xed, 0x69, 0xe0, 0xbd, 0xa7, 0x81, 0x87, 0xbe, 0x26, 0x75, 0xfb, 0x9a,
+	0xf4, 0xda, 0xd7, 0xa4, 0x8b, 0x3d, 0x62, 0x8a, 0x7a, 0xb3, 0xa2, 0x57, 0x19, 0x45, 0xd8, 0xc5,
+	0x19, 0x0b, 0x8b, 0x16, 0x73, 0x2e, 0x87, 0x67, 0x64, 0xd8, 0xf6, 0xf4, 0xae, 0x54, 0xfe, 0x8f,
+	0x36, 0xe1, 0xe0, 0x63, 0x00, 0xf9, 0xe1, 0xe7, 0xdc, 0xb0, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateAllowlist replaces the wasm allowlist. It can only be executed by the
+	// governance authority.
+	UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlist, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error)
+	// UpdateMigrationAllowlist replaces the wasm migration allowlist. It can only
+	// be executed by the governance authority.
+	UpdateMigrationAllowlist(ctx context.Context, in *MsgUpdateMigrationAllowlist, opts ...grpc.CallOption) (*MsgUpdateMigrationAllowlistResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateAllowlist(ctx context.Context, in *MsgUpdateAllowlist, opts ...grpc.CallOption) (*MsgUpdateAllowlistResponse, error) {
+	out := new(MsgUpdateAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/eve.wasmallowlist.v1.Msg/UpdateAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMigrationAllowlist(ctx context.Context, in *MsgUpdateMigrationAllowlist, opts ...grpc.CallOption) (*MsgUpdateMigrationAllowlistResponse, error) {
+	out := new(MsgUpdateMigrationAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/eve.wasmallowlist.v1.Msg/UpdateMigrationAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateAllowlist replaces the wasm allowlist. It can only be executed by the
+	// governance authority.
+	UpdateAllowlist(context.Context, *MsgUpdateAllowlist) (*MsgUpdateAllowlistResponse, error)
+	// UpdateMigrationAllowlist replaces the wasm migration allowlist. It can only
+	// be executed by the governance authority.
+	UpdateMigrationAllowlist(context.Context, *MsgUpdateMigrationAllowlist) (*MsgUpdateMigrationAllowlistResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateAllowlist(ctx context.Context, req *MsgUpdateAllowlist) (*MsgUpdateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMigrationAllowlist(ctx context.Context, req *MsgUpdateMigrationAllowlist) (*MsgUpdateMigrationAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMigrationAllowlist not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.wasmallowlist.v1.Msg/UpdateAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowlist(ctx, req.(*MsgUpdateAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMigrationAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMigrationAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMigrationAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eve.wasmallowlist.v1.Msg/UpdateMigrationAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMigrationAllowlist(ctx, req.(*MsgUpdateMigrationAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eve.wasmallowlist.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateAllowlist",
+			Handler:    _Msg_UpdateAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateMigrationAllowlist",
+			Handler:    _Msg_UpdateMigrationAllowlist_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eve/wasmallowlist/v1/tx.proto",
+}
+
+func (m *MsgUpdateAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMigrationAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMigrationAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMigrationAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MigrationAllowlist.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMigrationAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMigrationAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMigrationAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allowlist.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMigrationAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MigrationAllowlist.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMigrationAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMigrationAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMigrationAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMigrationAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationAllowlist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MigrationAllowlist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMigrationAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMigrationAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMigrationAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var ibcModuleType = reflect.TypeOf((*porttypes.IBCModule)(nil)).Elem()
+
+// ibcRoutes are the routes of the IBC router of the app.
+var ibcRoutes = []string{
+	ibctransfertypes.ModuleName,
+	wasmtypes.ModuleName,
+	icacontrollertypes.SubModuleName,
+	icahosttypes.SubModuleName,
+	feeabstypes.ModuleName,
+}
+
+// optionalKeepers are the keepers held by other keepers which the app leaves
+// unset on purpose.
+var optionalKeepers = map[string]bool{
+	// only read by the before send hooks, which this tokenfactory doesn't have
+	"EveApp.TokenFactoryKeeper.contractKeeper": true,
+}
+
+// validateKeeperWiring checks that every keeper of the app, every keeper held
+// by the modules of the IBC router and every keeper these keepers depend on
+// has been constructed. Keepers passed by value before their construction end
+// up as zero values, which only panic once a message or a packet reaches them.
+func validateKeeperWiring(app *EveApp) error {
+	appValue := reflect.ValueOf(app).Elem()
+	appType := appValue.Type()
+	for i := 0; i < appType.NumField(); i++ {
+		field := appType.Field(i)
+		if !field.IsExported() || !strings.HasSuffix(field.Name, "Keeper") {
+			continue
+		}
+		path := "EveApp." + field.Name
+		if err := validateKeeper(path, appValue.Field(i), make(map[uintptr]bool)); err != nil {
+			return err
+		}
+	}
+
+	if app.IBCKeeper.Router == nil {
+		return fmt.Errorf("keeper EveApp.IBCKeeper has no router")
+	}
+	for _, route := range ibcRoutes {
+		module, found := app.IBCKeeper.Router.GetRoute(route)
+		if !found {
+			return fmt.Errorf("IBC route %s is not set", route)
+		}
+		if err := validateIBCModuleWiring(route, reflect.ValueOf(module)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateIBCModuleWiring checks the keepers held by an IBC module and, for
+// middlewares, by the modules they wrap. path names the module in the
+// returned error.
+func validateIBCModuleWiring(path string, module reflect.Value) error {
+	module, ok := structOf(module, nil)
+	if !ok {
+		return nil
+	}
+
+	moduleType := module.Type()
+	for i := 0; i < moduleType.NumField(); i++ {
+		field := moduleType.Field(i)
+		value := module.Field(i)
+		fieldPath := path + "." + field.Name
+		switch {
+		case isKeeper(field):
+			if err := validateKeeper(fieldPath, value, make(map[uintptr]bool)); err != nil {
+				return fmt.Errorf("IBC route %s: %w", strings.SplitN(path, ".", 2)[0], err)
+			}
+		case isIBCModule(value):
+			// a nil wrapped module is allowed, e.g. the ICA controller
+			// middleware without an authentication module
+			if err := validateIBCModuleWiring(fieldPath, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateKeeper checks that the keeper is set and, recursively, the keepers
+// it holds. visited holds the keepers already checked through a pointer, as
+// keepers can reference each other.
+func validateKeeper(path string, keeper reflect.Value, visited map[uintptr]bool) error {
+	if optionalKeepers[path] {
+		return nil
+	}
+	if isUnset(keeper) {
+		return fmt.Errorf("keeper %s is not set", path)
+	}
+
+	keeper, ok := structOf(keeper, visited)
+	if !ok {
+		return nil
+	}
+	keeperType := keeper.Type()
+	for i := 0; i < keeperType.NumField(); i++ {
+		field := keeperType.Field(i)
+		if !isKeeper(field) {
+			continue
+		}
+		if err := validateKeeper(path+"."+field.Name, keeper.Field(i), visited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structOf dereferences the value down to a struct, returning false if there
+// is none or, when visited is set, if it was already visited.
+func structOf(value reflect.Value, visited map[uintptr]bool) (reflect.Value, bool) {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return value, false
+		}
+		if value.Kind() == reflect.Pointer && visited != nil {
+			if visited[value.Pointer()] {
+				return value, false
+			}
+			visited[value.Pointer()] = true
+		}
+		value = value.Elem()
+	}
+	return value, value.Kind() == reflect.Struct
+}
+
+func isKeeper(field reflect.StructField) bool {
+	return strings.Contains(strings.ToLower(field.Name), "keeper") ||
+		strings.HasSuffix(field.Type.Name(), "Keeper")
+}
+
+func isIBCModule(value reflect.Value) bool {
+	if value.Kind() == reflect.Interface {
+		return !value.IsNil() && value.Elem().Type().Implements(ibcModuleType)
+	}
+	return value.Type().Implements(ibcModuleType)
+}
+
+// isUnset returns true for nil pointers and interfaces and for zero values,
+// including the ones held by an interface.
+func isUnset(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Func, reflect.Slice:
+		return value.IsNil()
+	case reflect.Interface:
+		if value.IsNil() {
+			return true
+		}
+		return isUnset(value.Elem())
+	default:
+		return value.IsZero()
+	}
+}
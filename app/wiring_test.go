@@ -0,0 +1,56 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	feeabskeeper "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/keeper"
+	feeabstypes "github.com/osmosis-labs/fee-abstraction/v8/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+func TestValidateKeeperWiring(t *testing.T) {
+	app := Setup(t)
+	require.NoError(t, validateKeeperWiring(app))
+
+	// a wasm stack built with the wasm keeper before its construction
+	stack := ibcfee.NewIBCMiddleware(wasm.NewIBCHandler(wasmkeeper.Keeper{}, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper), app.IBCFeeKeeper)
+	require.EqualError(t, validateIBCModuleWiring("wasm", reflect.ValueOf(stack)), "IBC route wasm: keeper wasm.app.keeper is not set")
+
+	// a feeabs keeper built with the transfer keeper before its construction
+	feeabsKeeper := app.FeeabsKeeper
+	app.FeeabsKeeper = feeabskeeper.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(feeabstypes.StoreKey),
+		app.GetSubspace(feeabstypes.ModuleName),
+		&app.StakingKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		ibctransferkeeper.Keeper{},
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.PortKeeper,
+		app.ScopedFeeabsKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	require.EqualError(t, validateKeeperWiring(app), "keeper EveApp.FeeabsKeeper.transferKeeper is not set")
+
+	app.FeeabsKeeper = feeabskeeper.Keeper{}
+	require.EqualError(t, validateKeeperWiring(app), "keeper EveApp.FeeabsKeeper is not set")
+
+	// a fee grant keeper without its bank keeper
+	app.FeeabsKeeper = feeabsKeeper
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(app.AppCodec(), runtime.NewKVStoreService(app.GetKey(feegrant.StoreKey)), app.AccountKeeper)
+	require.EqualError(t, validateKeeperWiring(app), "keeper EveApp.FeeGrantKeeper.bankKeeper is not set")
+}
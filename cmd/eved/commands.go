@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -51,6 +52,26 @@ func initCometBFTConfig() *cmtcfg.Config {
 	return cfg
 }
 
+// the lines of the SDK's app.toml template after which Eve's options of the
+// [grpc] and [mempool] sections are written
+const (
+	grpcEnableTemplate    = "enable = {{ .GRPC.Enable }}\n"
+	mempoolMaxTxsTemplate = "max-txs = {{ .Mempool.MaxTxs }}\n"
+)
+
+const grpcCosmosReflectionTemplate = `
+# EnableCosmosReflection registers the cosmos.reflection.v1 service on the gRPC
+# server. The gRPC server reflection and the cosmos.base.reflection.v2alpha1
+# services are always registered.
+enable-cosmos-reflection = {{ .GRPCEnableCosmosReflection }}
+`
+
+const relayerLaneRatioTemplate = `
+# RelayerLaneRatio is the share of max-txs reserved for the IBC relayer
+# transactions, between 0 and 1.
+relayer-lane-ratio = {{ .RelayerLaneRatio }}
+`
+
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
@@ -60,6 +81,10 @@ func initAppConfig() (string, interface{}) {
 		serverconfig.Config
 
 		Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+
+		// Eve's options of the SDK's [grpc] and [mempool] sections
+		GRPCEnableCosmosReflection bool    `mapstructure:"-"`
+		RelayerLaneRatio           float64 `mapstructure:"-"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -81,11 +106,16 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultWasmConfig(),
+		Config:                     *srvCfg,
+		Wasm:                       wasmtypes.DefaultWasmConfig(),
+		GRPCEnableCosmosReflection: true,
+		RelayerLaneRatio:           app.DefaultRelayerLaneRatio,
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate +
+	customAppTemplate := strings.NewReplacer(
+		grpcEnableTemplate, grpcEnableTemplate+grpcCosmosReflectionTemplate,
+		mempoolMaxTxsTemplate, mempoolMaxTxsTemplate+relayerLaneRatioTemplate,
+	).Replace(serverconfig.DefaultConfigTemplate) +
 		wasmtypes.DefaultConfigTemplate()
 
 	return customAppTemplate, customAppConfig
@@ -106,6 +136,8 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		exportBalancesCmd(app.DefaultNodeHome),
+		diffBalancesCmd(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
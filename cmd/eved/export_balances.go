@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/eve-network/eve/app"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const flagExcludeSelfDelegations = "exclude-self-delegations"
+
+// exportBalancesCmd dumps the account balances, delegations included, at a
+// given height as a JSON list of bank balances. The node must be stopped.
+func exportBalancesCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-balances",
+		Short: "Export the account balances, delegated tokens included, at a given height",
+		Long: `Export the balances of every account at a given height as a JSON list of bank balances,
+counting the delegated tokens in the bond denom. Module accounts are left out, and so are the
+validators' self-delegations with --exclude-self-delegations. The node must be stopped and the
+height must not be pruned.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			serverCtx.Config.SetRoot(homeDir)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			eveApp := app.NewEveApp(serverCtx.Logger, db, nil, true, serverCtx.Viper, nil)
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			excludeSelfDelegations, _ := cmd.Flags().GetBool(flagExcludeSelfDelegations)
+			balances, err := eveApp.ExportBalancesAtHeight(height, excludeSelfDelegations)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(balances, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return err
+			}
+			return os.WriteFile(outputDocument, out, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, 0, "Export the balances at a particular height (0 means latest height)")
+	cmd.Flags().Bool(flagExcludeSelfDelegations, false, "Leave out the tokens validators delegated to themselves")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Balances are written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// diffBalancesCmd compares two exports of export-balances.
+func diffBalancesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff-balances [old-balances-file] [new-balances-file]",
+		Short: "Compare two exports of export-balances",
+		Long: `Compare two exports of export-balances, listing the addresses added, the addresses removed
+and the addresses whose balance changed from the old export to the new one.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldBalances, err := readBalances(args[0])
+			if err != nil {
+				return err
+			}
+			newBalances, err := readBalances(args[1])
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(app.DiffAllocations(oldBalances, newBalances), "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return err
+		},
+	}
+}
+
+func readBalances(path string) ([]banktypes.Balance, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var balances []banktypes.Balance
+	if err := json.Unmarshal(bz, &balances); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return balances, nil
+}